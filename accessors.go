@@ -0,0 +1,69 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Get returns the value of key converted to T, or def if the key is missing or nil.
+// Conversions are strict unless WeaklyTyped is passed. On a failed conversion
+// def is returned together with the error.
+func Get[T any](m map[string]any, key string, def T, opts ...DecodeOption) (T, error) {
+	return get("Get", m, key, def, opts...)
+}
+
+func GetString(m map[string]any, key string, def string, opts ...DecodeOption) (string, error) {
+	return get("GetString", m, key, def, opts...)
+}
+
+func GetInt(m map[string]any, key string, def int, opts ...DecodeOption) (int, error) {
+	return get("GetInt", m, key, def, opts...)
+}
+
+func GetFloat(m map[string]any, key string, def float64, opts ...DecodeOption) (float64, error) {
+	return get("GetFloat", m, key, def, opts...)
+}
+
+func GetBool(m map[string]any, key string, def bool, opts ...DecodeOption) (bool, error) {
+	return get("GetBool", m, key, def, opts...)
+}
+
+// GetDuration decodes strings like "5s" with time.ParseDuration.
+func GetDuration(m map[string]any, key string, def time.Duration, opts ...DecodeOption) (time.Duration, error) {
+	return get("GetDuration", m, key, def, opts...)
+}
+
+// GetTime decodes RFC 3339 strings.
+func GetTime(m map[string]any, key string, def time.Time, opts ...DecodeOption) (time.Time, error) {
+	return get("GetTime", m, key, def, opts...)
+}
+
+func GetSlice[T any](m map[string]any, key string, def []T, opts ...DecodeOption) ([]T, error) {
+	return get("GetSlice", m, key, def, opts...)
+}
+
+func GetMap(m map[string]any, key string, def map[string]any, opts ...DecodeOption) (map[string]any, error) {
+	return get("GetMap", m, key, def, opts...)
+}
+
+func get[T any](name string, m map[string]any, key string, def T, opts ...DecodeOption) (T, error) {
+	val, ok := m[key]
+	if !ok || val == nil {
+		return def, nil
+	}
+
+	var result T
+
+	d := newDecoder(opts...)
+	if err := d.decode(key, val, reflect.ValueOf(&result).Elem()); err != nil {
+		return def, fmt.Errorf("map_utils.%s: %w", name, err)
+	}
+
+	return result, nil
+}
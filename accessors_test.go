@@ -0,0 +1,148 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestGetters(t *testing.T) {
+	m := map[string]any{
+		"name":     "server",
+		"port":     8080.0,
+		"ratio":    0.5,
+		"debug":    true,
+		"timeout":  "5s",
+		"interval": time.Second,
+		"started":  "2026-01-18T10:00:00Z",
+		"hosts":    []any{"a", "b"},
+		"ports":    []any{80.0, "443"},
+		"tls":      map[string]any{"enabled": true},
+		"count":    "3",
+		"null":     nil,
+	}
+
+	t.Run("string", func(t *testing.T) {
+		val, err := map_utils.GetString(m, "name", "default")
+		assert.NoError(t, err)
+		assert.Equal(t, "server", val)
+	})
+
+	t.Run("missing key returns default", func(t *testing.T) {
+		val, err := map_utils.GetString(m, "missing", "default")
+		assert.NoError(t, err)
+		assert.Equal(t, "default", val)
+	})
+
+	t.Run("nil value returns default", func(t *testing.T) {
+		val, err := map_utils.GetInt(m, "null", 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, val)
+	})
+
+	t.Run("integral float to int", func(t *testing.T) {
+		val, err := map_utils.GetInt(m, "port", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, val)
+	})
+
+	t.Run("fractional float to int", func(t *testing.T) {
+		val, err := map_utils.GetInt(m, "ratio", 1)
+		assert.EqualError(t, err, "map_utils.GetInt: ratio: value 0.5 is not a valid int")
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		val, err := map_utils.GetFloat(m, "ratio", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0.5, val)
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		val, err := map_utils.GetBool(m, "debug", false)
+		assert.NoError(t, err)
+		assert.True(t, val)
+	})
+
+	t.Run("strict string to int", func(t *testing.T) {
+		val, err := map_utils.GetInt(m, "count", 1)
+		assert.Error(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("lenient string to int", func(t *testing.T) {
+		val, err := map_utils.GetInt(m, "count", 1, map_utils.WeaklyTyped())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, val)
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		val, err := map_utils.GetDuration(m, "interval", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, val)
+
+		val, err = map_utils.GetDuration(m, "timeout", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 5*time.Second, val)
+
+		val, err = map_utils.GetDuration(m, "port", time.Minute)
+		assert.EqualError(t, err, "map_utils.GetDuration: port: cannot convert float64 to time.Duration")
+		assert.Equal(t, time.Minute, val)
+
+		val, err = map_utils.GetDuration(m, "name", time.Minute)
+		assert.ErrorContains(t, err, `map_utils.GetDuration: name: cannot parse "server"`)
+		assert.Equal(t, time.Minute, val)
+
+		val, err = map_utils.GetDuration(m, "port", 0, map_utils.WeaklyTyped())
+		assert.NoError(t, err)
+		assert.Equal(t, 8080*time.Nanosecond, val)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		val, err := map_utils.GetTime(m, "started", time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC), val)
+
+		_, err = map_utils.GetTime(m, "port", time.Time{})
+		assert.EqualError(t, err, "map_utils.GetTime: port: cannot convert float64 to time.Time")
+
+		val, err = map_utils.GetTime(m, "port", time.Time{}, map_utils.WeaklyTyped())
+		assert.NoError(t, err)
+		assert.Equal(t, time.Unix(8080, 0).UTC(), val)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		hosts, err := map_utils.GetSlice[string](m, "hosts", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, hosts)
+
+		_, err = map_utils.GetSlice[int](m, "ports", nil)
+		assert.EqualError(t, err, "map_utils.GetSlice: ports[1]: cannot convert string to int")
+
+		ports, err := map_utils.GetSlice[int](m, "ports", nil, map_utils.WeaklyTyped())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{80, 443}, ports)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		val, err := map_utils.GetMap(m, "tls", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"enabled": true}, val)
+
+		_, err = map_utils.GetMap(m, "name", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("generic", func(t *testing.T) {
+		val, err := map_utils.Get[uint16](m, "port", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(8080), val)
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// CanonicalJSON encodes m as canonical JSON according to RFC 8785 (JCS).
+// Keys are formatted with fmt.Sprint and sorted by their UTF-16 code units.
+// Values that are not maps, slices, strings, booleans or numbers are
+// encoded with encoding/json first and then canonicalized.
+func CanonicalJSON[K cmp.Ordered, V any](m map[K]V) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if m == nil {
+		m = map[K]V{}
+	}
+
+	if err := writeCanonical(&buf, "", m); err != nil {
+		return nil, fmt.Errorf("map_utils.CanonicalJSON: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Hash writes the canonical JSON encoding of m to h and returns the digest.
+func Hash[K cmp.Ordered, V any](m map[K]V, h hash.Hash) ([]byte, error) {
+	data, err := CanonicalJSON(m)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Reset()
+	h.Write(data)
+
+	return h.Sum(nil), nil
+}
+
+// Fingerprint returns the hex encoded SHA-256 digest of the canonical JSON encoding of m.
+func Fingerprint[K cmp.Ordered, V any](m map[K]V) (string, error) {
+	sum, err := Hash(m, sha256.New())
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(sum), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, path string, val any) error {
+	switch v := val.(type) {
+	case nil:
+		buf.WriteString("null")
+		return nil
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+		return nil
+	case string:
+		return writeCanonicalString(buf, path, v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return &FieldError{Path: path, Err: err}
+		}
+
+		return writeCanonicalNumber(buf, path, f)
+	case json.Marshaler:
+		return writeCanonicalJSON(buf, path, val)
+	}
+
+	rv := reflect.ValueOf(val)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := rv.Int(); i > 1<<53 || i < -(1<<53) {
+			return &FieldError{Path: path, Err: fmt.Errorf("integer %d exceeds the exact range of IEEE 754 doubles", i)}
+		}
+
+		return writeCanonicalNumber(buf, path, float64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u > 1<<53 {
+			return &FieldError{Path: path, Err: fmt.Errorf("integer %d exceeds the exact range of IEEE 754 doubles", u)}
+		}
+
+		return writeCanonicalNumber(buf, path, float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return writeCanonicalNumber(buf, path, rv.Float())
+	case reflect.String:
+		return writeCanonicalString(buf, path, rv.String())
+	case reflect.Bool:
+		buf.WriteString(strconv.FormatBool(rv.Bool()))
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+
+		return writeCanonical(buf, path, rv.Elem().Interface())
+	case reflect.Map:
+		if rv.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+
+		return writeCanonicalMap(buf, path, rv)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return writeCanonicalJSON(buf, path, val)
+		}
+
+		buf.WriteByte('[')
+		for i := range rv.Len() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCanonical(buf, fmt.Sprintf("%s[%d]", path, i), rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+		return nil
+	default:
+		return writeCanonicalJSON(buf, path, val)
+	}
+}
+
+func writeCanonicalMap(buf *bytes.Buffer, path string, rv reflect.Value) error {
+	type entry struct {
+		key string
+		val any
+	}
+
+	entries := make([]entry, 0, rv.Len())
+	for _, k := range rv.MapKeys() {
+		entries = append(entries, entry{key: formatText(k.Interface()), val: rv.MapIndex(k).Interface()})
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return slices.Compare(utf16.Encode([]rune(a.key)), utf16.Encode([]rune(b.key)))
+	})
+
+	buf.WriteByte('{')
+	for i, e := range entries {
+		if i > 0 {
+			if e.key == entries[i-1].key {
+				return &FieldError{Path: path, Err: fmt.Errorf("duplicate key %q", e.key)}
+			}
+
+			buf.WriteByte(',')
+		}
+
+		if err := writeCanonicalString(buf, path, e.key); err != nil {
+			return err
+		}
+
+		buf.WriteByte(':')
+
+		if err := writeCanonical(buf, joinPath(path, e.key), e.val); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+// writeCanonicalJSON encodes val with encoding/json and canonicalizes the result.
+func writeCanonicalJSON(buf *bytes.Buffer, path string, val any) error {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return &FieldError{Path: path, Err: err}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return &FieldError{Path: path, Err: err}
+	}
+
+	return writeCanonical(buf, path, generic)
+}
+
+func writeCanonicalNumber(buf *bytes.Buffer, path string, f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return &FieldError{Path: path, Err: fmt.Errorf("%v is not a valid JSON number", f)}
+	}
+
+	if f == 0 {
+		buf.WriteByte('0')
+		return nil
+	}
+
+	if abs := math.Abs(f); abs >= 1e-6 && abs < 1e21 {
+		buf.WriteString(strconv.FormatFloat(f, 'f', -1, 64))
+		return nil
+	}
+
+	// ECMAScript writes exponents without leading zeros, e.g. 1e-7 instead of 1e-07
+	mantissa, exp, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	sign := exp[0]
+	exp = strings.TrimLeft(exp[1:], "0")
+
+	buf.WriteString(mantissa)
+	buf.WriteByte('e')
+	buf.WriteByte(sign)
+	buf.WriteString(exp)
+
+	return nil
+}
+
+func writeCanonicalString(buf *bytes.Buffer, path string, s string) error {
+	if !utf8.ValidString(s) {
+		return &FieldError{Path: path, Err: fmt.Errorf("invalid UTF-8 in string %q", s)}
+	}
+
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+
+	return nil
+}
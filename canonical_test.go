@@ -0,0 +1,117 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	t.Run("rfc 8785 example", func(t *testing.T) {
+		var m map[string]any
+		err := json.Unmarshal([]byte(`{
+			"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+			"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+			"literals": [null, true, false]
+		}`), &m)
+		assert.NoError(t, err)
+
+		data, err := map_utils.CanonicalJSON(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`, string(data))
+	})
+
+	t.Run("key order by utf-16 code units", func(t *testing.T) {
+		m := map[string]int{"\U0001F600": 1, "דּ": 2, "b": 3, "a": 4, "10": 5, "2": 6}
+		data, err := map_utils.CanonicalJSON(m)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"10\":5,\"2\":6,\"a\":4,\"b\":3,\"\U0001F600\":1,\"דּ\":2}", string(data))
+	})
+
+	t.Run("typed values", func(t *testing.T) {
+		type point struct {
+			Y int `json:"y"`
+			X int `json:"x"`
+		}
+
+		m := map[int]any{
+			2: point{Y: 2, X: 1},
+			1: time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC),
+			3: map[string][]int{"b": {1}, "a": nil},
+			4: -0.0,
+			5: uint8(7),
+		}
+
+		data, err := map_utils.CanonicalJSON(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"1":"2026-01-18T00:00:00Z","2":{"x":1,"y":2},"3":{"a":null,"b":[1]},"4":0,"5":7}`, string(data))
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		data, err := map_utils.CanonicalJSON[string, any](nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", string(data))
+	})
+
+	t.Run("invalid numbers", func(t *testing.T) {
+		_, err := map_utils.CanonicalJSON(map[string]any{"a": map[string]any{"b": math.NaN()}})
+		assert.EqualError(t, err, "map_utils.CanonicalJSON: a.b: NaN is not a valid JSON number")
+
+		_, err = map_utils.CanonicalJSON(map[string]int64{"a": 1 << 60})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid utf-8", func(t *testing.T) {
+		_, err := map_utils.CanonicalJSON(map[string]string{"a": "\xff"})
+		assert.Error(t, err)
+	})
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Run("independent of construction order", func(t *testing.T) {
+		a := map[string]any{}
+		b := map[string]any{}
+
+		for i := range 100 {
+			a[string(rune('a'+i%26))+string(rune('0'+i/26))] = i
+		}
+
+		for i := 99; i >= 0; i-- {
+			b[string(rune('a'+i%26))+string(rune('0'+i/26))] = float64(i)
+		}
+
+		fa, err := map_utils.Fingerprint(a)
+		assert.NoError(t, err)
+
+		fb, err := map_utils.Fingerprint(b)
+		assert.NoError(t, err)
+
+		assert.Equal(t, fa, fb)
+		assert.Len(t, fa, 64)
+	})
+
+	t.Run("different content", func(t *testing.T) {
+		fa, _ := map_utils.Fingerprint(map[string]int{"a": 1})
+		fb, _ := map_utils.Fingerprint(map[string]int{"a": 2})
+		assert.NotEqual(t, fa, fb)
+	})
+
+	t.Run("custom hash", func(t *testing.T) {
+		sum, err := map_utils.Hash(map[string]int{"a": 1}, md5.New())
+		assert.NoError(t, err)
+
+		expected := md5.Sum([]byte(`{"a":1}`))
+		assert.Equal(t, hex.EncodeToString(expected[:]), hex.EncodeToString(sum))
+	})
+}
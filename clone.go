@@ -0,0 +1,146 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	clonersMu sync.RWMutex
+	cloners   = map[reflect.Type]func(any) any{}
+)
+
+// RegisterCloner registers f to copy values of type T in DeepClone.
+// Registering a cloner for the same type again replaces it.
+func RegisterCloner[T any](f func(val T) T) {
+	clonersMu.Lock()
+	defer clonersMu.Unlock()
+
+	cloners[reflect.TypeFor[T]()] = func(val any) any {
+		return f(val.(T))
+	}
+}
+
+// DeepClone returns a copy of m where all nested map[string]any and []any values
+// are copied as well. Values with a registered cloner are copied with it, all other
+// values are copied by assignment. It fails if a map or slice contains itself.
+func DeepClone(m map[string]any) (map[string]any, error) {
+	c := cloner{visiting: map[uintptr]bool{}}
+
+	result, err := c.cloneMap("", m)
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.DeepClone: %w", err)
+	}
+
+	return result, nil
+}
+
+// CloneFunc returns a copy of m with all values copied by cloneValue.
+func CloneFunc[K comparable, V any](m map[K]V, cloneValue func(val V) V) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		result[k] = cloneValue(v)
+	}
+
+	return result
+}
+
+var errCycle = errors.New("cycle detected")
+
+type cloner struct {
+	// visiting holds the maps and slices on the current path
+	visiting map[uintptr]bool
+}
+
+func (c cloner) enter(path string, ptr uintptr) error {
+	if c.visiting[ptr] {
+		return &FieldError{Path: path, Err: errCycle}
+	}
+
+	c.visiting[ptr] = true
+	return nil
+}
+
+func (c cloner) cloneMap(path string, m map[string]any) (map[string]any, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	ptr := reflect.ValueOf(m).Pointer()
+	if err := c.enter(path, ptr); err != nil {
+		return nil, err
+	}
+	defer delete(c.visiting, ptr)
+
+	result := make(map[string]any, len(m))
+	for k, v := range m {
+		val, err := c.clone(joinPath(path, k), v)
+		if err != nil {
+			return nil, err
+		}
+
+		result[k] = val
+	}
+
+	return result, nil
+}
+
+func (c cloner) cloneSlice(path string, s []any) ([]any, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	if len(s) > 0 {
+		ptr := reflect.ValueOf(s).Pointer()
+		if err := c.enter(path, ptr); err != nil {
+			return nil, err
+		}
+		defer delete(c.visiting, ptr)
+	}
+
+	result := make([]any, len(s))
+	for i, v := range s {
+		val, err := c.clone(fmt.Sprintf("%s[%d]", path, i), v)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = val
+	}
+
+	return result, nil
+}
+
+func (c cloner) clone(path string, val any) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	clonersMu.RLock()
+	f, ok := cloners[reflect.TypeOf(val)]
+	clonersMu.RUnlock()
+
+	if ok {
+		return f(val), nil
+	}
+
+	switch v := val.(type) {
+	case map[string]any:
+		return c.cloneMap(path, v)
+	case []any:
+		return c.cloneSlice(path, v)
+	default:
+		return val, nil
+	}
+}
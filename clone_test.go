@@ -0,0 +1,105 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+type cloneCounter struct {
+	Values []int
+}
+
+func TestDeepClone(t *testing.T) {
+	t.Run("nested", func(t *testing.T) {
+		m := testTree()
+
+		result, err := map_utils.DeepClone(m)
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+
+		result["db"].(map[string]any)["host"] = "remote"
+		result["db"].(map[string]any)["users"].([]any)[1].(map[string]any)["name"] = "carol"
+		result["tags"].([]any)[0] = "x"
+
+		assert.Equal(t, testTree(), m)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		result, err := map_utils.DeepClone(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = map_utils.DeepClone(map[string]any{"a": nil, "b": []any(nil)})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": nil, "b": []any(nil)}, result)
+	})
+
+	t.Run("shared values", func(t *testing.T) {
+		shared := map[string]any{"a": 1}
+
+		result, err := map_utils.DeepClone(map[string]any{"x": shared, "y": shared})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"x": shared, "y": shared}, result)
+	})
+
+	t.Run("map cycle", func(t *testing.T) {
+		m := map[string]any{"a": map[string]any{}}
+		m["a"].(map[string]any)["self"] = m
+
+		_, err := map_utils.DeepClone(m)
+		assert.EqualError(t, err, "map_utils.DeepClone: a.self: cycle detected")
+	})
+
+	t.Run("slice cycle", func(t *testing.T) {
+		s := []any{1, nil}
+		s[1] = s
+
+		_, err := map_utils.DeepClone(map[string]any{"s": s})
+		assert.EqualError(t, err, "map_utils.DeepClone: s[1]: cycle detected")
+	})
+
+	t.Run("registered cloner", func(t *testing.T) {
+		map_utils.RegisterCloner(func(val *cloneCounter) *cloneCounter {
+			return &cloneCounter{Values: slices.Clone(val.Values)}
+		})
+
+		counter := &cloneCounter{Values: []int{1, 2}}
+		m := map[string]any{"counter": counter, "list": []any{counter}}
+
+		result, err := map_utils.DeepClone(m)
+		assert.NoError(t, err)
+
+		cloned := result["counter"].(*cloneCounter)
+		assert.NotSame(t, counter, cloned)
+		assert.NotSame(t, counter, result["list"].([]any)[0])
+
+		cloned.Values[0] = 5
+		assert.Equal(t, []int{1, 2}, counter.Values)
+	})
+}
+
+func TestCloneFunc(t *testing.T) {
+	m := map[string][]int{"a": {1, 2}, "b": {3}}
+
+	result := map_utils.CloneFunc(m, slices.Clone)
+	assert.Equal(t, m, result)
+
+	result["a"][0] = 5
+	assert.Equal(t, []int{1, 2}, m["a"])
+
+	nested := map[string]map[string]int{"x": {"a": 1}}
+	clonedNested := map_utils.CloneFunc(nested, maps.Clone)
+	clonedNested["x"]["a"] = 2
+	assert.Equal(t, 1, nested["x"]["a"])
+
+	assert.Nil(t, map_utils.CloneFunc[string, []int](nil, slices.Clone))
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type CSVOptions struct {
+	// Comma is the field delimiter, ',' if zero. Use '\t' for TSV.
+	Comma rune
+	// Header sets the columns and their order. WriteCSV derives the sorted
+	// union of all keys if it is nil.
+	Header []string
+	// NoHeader omits the header row. ReadCSV then takes the columns from Header.
+	NoHeader bool
+	// Format converts values to text, formatText is used if nil.
+	Format func(column string, val any) string
+	// Parse converts cells to values. If nil, cells are kept as strings
+	// or converted to int, float64 or bool if InferTypes is set.
+	Parse      func(column string, val string) (any, error)
+	InferTypes bool
+	// KeepEmpty keeps empty cells as empty values instead of omitting the key.
+	KeepEmpty bool
+}
+
+// WriteCSV writes rows as CSV. Keys missing in a row are written as empty cells.
+func WriteCSV[V any](w io.Writer, rows []map[string]V, opts CSVOptions) error {
+	header := opts.Header
+	if header == nil {
+		keys := map[string]struct{}{}
+		for _, row := range rows {
+			for k := range row {
+				keys[k] = struct{}{}
+			}
+		}
+
+		header = slices.Sorted(maps.Keys(keys))
+	}
+
+	cw := newCSVWriter(w, opts)
+
+	if !opts.NoHeader {
+		if err := cw.Write(header); err != nil {
+			return fmt.Errorf("map_utils.WriteCSV: %w", err)
+		}
+	}
+
+	record := make([]string, len(header))
+	for _, row := range rows {
+		for i, column := range header {
+			record[i] = ""
+			if val, ok := row[column]; ok {
+				record[i] = opts.format(column, val)
+			}
+		}
+
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("map_utils.WriteCSV: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("map_utils.WriteCSV: %w", err)
+	}
+
+	return nil
+}
+
+// ReadCSV reads CSV records into one map per row.
+func ReadCSV(r io.Reader, opts CSVOptions) ([]map[string]any, error) {
+	cr := newCSVReader(r, opts)
+
+	header := opts.Header
+	if !opts.NoHeader {
+		var err error
+		if header, err = cr.Read(); err == io.EOF {
+			return []map[string]any{}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSV: %w", err)
+		}
+	}
+
+	result := []map[string]any{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return result, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSV: %w", err)
+		}
+
+		if len(record) > len(header) {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("map_utils.ReadCSV: line %d: %d fields for %d columns", line, len(record), len(header))
+		}
+
+		row := map[string]any{}
+		for i, cell := range record {
+			if cell == "" && !opts.KeepEmpty {
+				continue
+			}
+
+			val, err := opts.parse(header[i], cell)
+			if err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("map_utils.ReadCSV: line %d: %w", line, &FieldError{Path: header[i], Err: err})
+			}
+
+			row[header[i]] = val
+		}
+
+		result = append(result, row)
+	}
+}
+
+// WriteCSVMap writes m as two columns sorted by key. The header is
+// "key,value" unless Header contains two other names.
+func WriteCSVMap[K cmp.Ordered, V any](w io.Writer, m map[K]V, opts CSVOptions) error {
+	header := opts.Header
+	if len(header) != 2 {
+		header = []string{"key", "value"}
+	}
+
+	cw := newCSVWriter(w, opts)
+
+	if !opts.NoHeader {
+		if err := cw.Write(header); err != nil {
+			return fmt.Errorf("map_utils.WriteCSVMap: %w", err)
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		if err := cw.Write([]string{opts.format(header[0], k), opts.format(header[1], m[k])}); err != nil {
+			return fmt.Errorf("map_utils.WriteCSVMap: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("map_utils.WriteCSVMap: %w", err)
+	}
+
+	return nil
+}
+
+// ReadCSVMap reads two column records into a map. Keys and values are
+// converted leniently to K and V.
+func ReadCSVMap[K comparable, V any](r io.Reader, opts CSVOptions) (map[K]V, error) {
+	cr := newCSVReader(r, opts)
+	cr.FieldsPerRecord = 2
+
+	if !opts.NoHeader {
+		if _, err := cr.Read(); err == io.EOF {
+			return map[K]V{}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSVMap: %w", err)
+		}
+	}
+
+	result := map[K]V{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return result, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSVMap: %w", err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		key, err := parseText[K](record[0])
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSVMap: line %d: key: %w", line, err)
+		}
+
+		val, err := parseText[V](record[1])
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ReadCSVMap: line %d: value: %w", line, err)
+		}
+
+		result[key] = val
+	}
+}
+
+func newCSVWriter(w io.Writer, opts CSVOptions) *csv.Writer {
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+
+	return cw
+}
+
+func newCSVReader(r io.Reader, opts CSVOptions) *csv.Reader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+
+	return cr
+}
+
+func (o CSVOptions) format(column string, val any) string {
+	if o.Format != nil {
+		return o.Format(column, val)
+	}
+
+	return formatText(val)
+}
+
+func (o CSVOptions) parse(column string, val string) (any, error) {
+	switch {
+	case o.Parse != nil:
+		return o.Parse(column, val)
+	case o.InferTypes:
+		return inferType(val), nil
+	default:
+		return val, nil
+	}
+}
+
+func inferType(s string) any {
+	if i, err := strconv.Atoi(s); err == nil {
+		return i
+	}
+
+	// only plain decimal numbers, not "NaN", "Inf" or hex floats
+	decimal := !strings.ContainsFunc(s, func(r rune) bool {
+		return !strings.ContainsRune("0123456789+-.eE", r)
+	})
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil && decimal && !math.IsInf(f, 0) {
+		return f
+	}
+
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return s
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestWriteCSV(t *testing.T) {
+	rows := []map[string]any{
+		{"name": "Bob", "age": 42},
+		{"name": "Alice, Jr.", "city": "Berlin"},
+	}
+
+	t.Run("union of keys", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSV(&buf, rows, map_utils.CSVOptions{}))
+		assert.Equal(t, "age,city,name\n42,,Bob\n,Berlin,\"Alice, Jr.\"\n", buf.String())
+	})
+
+	t.Run("explicit header as tsv", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSV(&buf, rows, map_utils.CSVOptions{Comma: '\t', Header: []string{"name", "age"}}))
+		assert.Equal(t, "name\tage\nBob\t42\nAlice, Jr.\t\n", buf.String())
+	})
+
+	t.Run("no header and formatter", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSV(&buf, []map[string]int{{"a": 1}}, map_utils.CSVOptions{
+			NoHeader: true,
+			Format: func(column string, val any) string {
+				return column + ":" + strconv.Itoa(val.(int))
+			},
+		}))
+		assert.Equal(t, "a:1\n", buf.String())
+	})
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader("age,city,name\n42,,Bob\n"), map_utils.CSVOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"age": "42", "name": "Bob"}}, rows)
+	})
+
+	t.Run("infer types", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader("a,b,c,d\n1,1.5,true,x\n"), map_utils.CSVOptions{InferTypes: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"a": 1, "b": 1.5, "c": true, "d": "x"}}, rows)
+	})
+
+	t.Run("non-decimal numbers stay strings", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader("a,b,c,d,e,f\nNaN,Inf,-infinity,0x1p-2,1e999,-2.5e3\n"), map_utils.CSVOptions{InferTypes: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"a": "NaN", "b": "Inf", "c": "-infinity", "d": "0x1p-2", "e": "1e999", "f": -2500.0}}, rows)
+	})
+
+	t.Run("keep empty cells", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader("a,b\n,x\n"), map_utils.CSVOptions{KeepEmpty: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"a": "", "b": "x"}}, rows)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		rows := []map[string]any{
+			{"name": "Bob", "age": 42},
+			{"name": "Alice, Jr.", "city": "Berlin", "score": 1.5},
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSV(&buf, rows, map_utils.CSVOptions{Comma: '\t'}))
+
+		result, err := map_utils.ReadCSV(&buf, map_utils.CSVOptions{Comma: '\t', InferTypes: true})
+		assert.NoError(t, err)
+		assert.Equal(t, rows, result)
+	})
+
+	t.Run("explicit header without header row", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader("1,2\n"), map_utils.CSVOptions{NoHeader: true, Header: []string{"x", "y"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"x": "1", "y": "2"}}, rows)
+	})
+
+	t.Run("custom parser error", func(t *testing.T) {
+		_, err := map_utils.ReadCSV(strings.NewReader("n\n1\nx\n"), map_utils.CSVOptions{
+			Parse: func(column string, val string) (any, error) {
+				return strconv.Atoi(val)
+			},
+		})
+		assert.EqualError(t, err, `map_utils.ReadCSV: line 3: n: strconv.Atoi: parsing "x": invalid syntax`)
+	})
+
+	t.Run("too many fields", func(t *testing.T) {
+		_, err := map_utils.ReadCSV(strings.NewReader("a\n1,2\n"), map_utils.CSVOptions{})
+		assert.EqualError(t, err, "map_utils.ReadCSV: line 2: 2 fields for 1 columns")
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		rows, err := map_utils.ReadCSV(strings.NewReader(""), map_utils.CSVOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, rows)
+	})
+}
+
+func TestCSVMap(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		m := map[string]int{"b": 2, "a": 1}
+
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSVMap(&buf, m, map_utils.CSVOptions{}))
+		assert.Equal(t, "key,value\na,1\nb,2\n", buf.String())
+
+		result, err := map_utils.ReadCSVMap[string, int](&buf, map_utils.CSVOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+	})
+
+	t.Run("custom header", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteCSVMap(&buf, map[int]bool{1: true}, map_utils.CSVOptions{Header: []string{"id", "active"}}))
+		assert.Equal(t, "id,active\n1,true\n", buf.String())
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := map_utils.ReadCSVMap[string, int](strings.NewReader("key,value\na,x\n"), map_utils.CSVOptions{})
+		assert.ErrorContains(t, err, `map_utils.ReadCSVMap: line 2: value: cannot parse "x" as int`)
+	})
+
+	t.Run("wrong number of fields", func(t *testing.T) {
+		_, err := map_utils.ReadCSVMap[string, string](strings.NewReader("key,value\na\n"), map_utils.CSVOptions{})
+		assert.Error(t, err)
+	})
+}
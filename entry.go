@@ -0,0 +1,84 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Entry is a single key/value pair. It is encoded as {"key":...,"value":...} in JSON.
+type Entry[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+func NewEntry[K any, V any](key K, val V) Entry[K, V] {
+	return Entry[K, V]{Key: key, Value: val}
+}
+
+// Entries returns the entries of m sorted by key.
+func Entries[K cmp.Ordered, V any](m map[K]V) []Entry[K, V] {
+	return SortEntriesByKey(slices.Collect(EntriesSeq(maps.All(m))))
+}
+
+// FromEntries builds a map from entries. Later entries overwrite earlier ones with the same key.
+func FromEntries[K comparable, V any](entries []Entry[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+	for _, e := range entries {
+		result[e.Key] = e.Value
+	}
+
+	return result
+}
+
+// EntriesSeq converts a key/value sequence into a sequence of entries.
+func EntriesSeq[K any, V any](m iter.Seq2[K, V]) iter.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for k, v := range m {
+			if !yield(Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	}
+}
+
+// EntriesSeq2 converts a sequence of entries back into a key/value sequence.
+func EntriesSeq2[K any, V any](s iter.Seq[Entry[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range s {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// SortEntriesByKey sorts entries in place by key and returns them.
+func SortEntriesByKey[K cmp.Ordered, V any](entries []Entry[K, V]) []Entry[K, V] {
+	slices.SortStableFunc(entries, func(a, b Entry[K, V]) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return entries
+}
+
+// SortEntriesByValue sorts entries in place by value, ties ordered by key, and returns them.
+func SortEntriesByValue[K cmp.Ordered, V cmp.Ordered](entries []Entry[K, V]) []Entry[K, V] {
+	slices.SortStableFunc(entries, func(a, b Entry[K, V]) int {
+		return cmp.Or(cmp.Compare(a.Value, b.Value), cmp.Compare(a.Key, b.Key))
+	})
+
+	return entries
+}
+
+// SortEntriesFunc sorts entries in place with compare and returns them.
+func SortEntriesFunc[K any, V any](entries []Entry[K, V], compare func(a, b Entry[K, V]) int) []Entry[K, V] {
+	slices.SortStableFunc(entries, compare)
+	return entries
+}
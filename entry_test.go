@@ -0,0 +1,116 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"c": 1, "a": 3, "b": 2}
+
+	t.Run("sorted by key", func(t *testing.T) {
+		assert.Equal(t, []map_utils.Entry[string, int]{
+			{Key: "a", Value: 3},
+			{Key: "b", Value: 2},
+			{Key: "c", Value: 1},
+		}, map_utils.Entries(m))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.Empty(t, map_utils.Entries(map[string]int{}))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		assert.Equal(t, m, map_utils.FromEntries(map_utils.Entries(m)))
+	})
+
+	t.Run("later entries win", func(t *testing.T) {
+		result := map_utils.FromEntries([]map_utils.Entry[string, int]{
+			map_utils.NewEntry("a", 1),
+			map_utils.NewEntry("a", 2),
+		})
+		assert.Equal(t, map[string]int{"a": 2}, result)
+	})
+}
+
+func TestEntriesSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("to entries and back", func(t *testing.T) {
+		entries := map_utils.EntriesSeq(maps.All(m))
+		assert.Equal(t, m, maps.Collect(map_utils.EntriesSeq2(entries)))
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		count := 0
+		map_utils.EntriesSeq(maps.All(m))(func(map_utils.Entry[string, int]) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+
+		count = 0
+		map_utils.EntriesSeq2(slices.Values(map_utils.Entries(m)))(func(string, int) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestSortEntries(t *testing.T) {
+	entries := func() []map_utils.Entry[string, int] {
+		return []map_utils.Entry[string, int]{
+			{Key: "b", Value: 2},
+			{Key: "c", Value: 1},
+			{Key: "a", Value: 2},
+		}
+	}
+
+	t.Run("by key", func(t *testing.T) {
+		result := map_utils.SortEntriesByKey(entries())
+		assert.Equal(t, []string{"a", "b", "c"}, []string{result[0].Key, result[1].Key, result[2].Key})
+	})
+
+	t.Run("by value with key tie break", func(t *testing.T) {
+		result := map_utils.SortEntriesByValue(entries())
+		assert.Equal(t, []map_utils.Entry[string, int]{
+			{Key: "c", Value: 1},
+			{Key: "a", Value: 2},
+			{Key: "b", Value: 2},
+		}, result)
+	})
+
+	t.Run("by func", func(t *testing.T) {
+		result := map_utils.SortEntriesFunc(entries(), func(a, b map_utils.Entry[string, int]) int {
+			return cmp.Compare(b.Key, a.Key)
+		})
+		assert.Equal(t, "c", result[0].Key)
+	})
+}
+
+func TestEntryJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(map_utils.Entries(map[string]int{"b": 2, "a": 1}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"key":"a","value":1},{"key":"b","value":2}]`, string(data))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var entries []map_utils.Entry[int, []string]
+		err := json.Unmarshal([]byte(`[{"key":1,"value":["x"]}]`), &entries)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int][]string{1: {"x"}}, map_utils.FromEntries(entries))
+	})
+}
@@ -0,0 +1,376 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// FromEnviron converts KEY=VALUE entries like the ones returned by
+// os.Environ into a map. Entries without "=" are ignored.
+func FromEnviron(env []string) map[string]string {
+	result := make(map[string]string, len(env))
+
+	for _, entry := range env {
+		// a leading "=" belongs to the key (e.g. "=C:" on Windows)
+		idx := strings.Index(entry[min(1, len(entry)):], "=")
+		if idx < 0 {
+			continue
+		}
+
+		idx += min(1, len(entry))
+		result[entry[:idx]] = entry[idx+1:]
+	}
+
+	return result
+}
+
+// ToEnviron converts m into KEY=VALUE entries sorted by key.
+func ToEnviron(m map[string]string) []string {
+	keys := slices.Collect(maps.Keys(m))
+	slices.Sort(keys)
+
+	result := make([]string, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, k+"="+m[k])
+	}
+
+	return result
+}
+
+// EnvPrefix selects the entries of m starting with prefix, strips the prefix
+// and builds nested maps by splitting the remaining keys at sep. An empty sep
+// keeps the keys flat.
+func EnvPrefix(m map[string]string, prefix string, sep string) (map[string]any, error) {
+	selected := map[string]string{}
+
+	for k, v := range m {
+		if name, ok := strings.CutPrefix(k, prefix); ok && name != "" {
+			selected[name] = v
+		}
+	}
+
+	if sep == "" {
+		return Convert(selected, func(key string, val string) (any, error) {
+			return val, nil
+		}), nil
+	}
+
+	result, err := expandKeys(selected, sep)
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.EnvPrefix: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseDotEnv reads a .env file. It supports comments, an optional "export"
+// prefix, single quoted literal values, double quoted values with escapes
+// spanning multiple lines, and ${VAR} or $VAR interpolation in unquoted and
+// double quoted values. Variables are resolved from earlier entries first and
+// then from lookup, which may be nil.
+func ParseDotEnv(r io.Reader, lookup func(key string) (string, bool)) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.ParseDotEnv: %w", err)
+	}
+
+	p := &dotEnvParser{
+		data:   string(data),
+		line:   1,
+		lookup: lookup,
+		result: map[string]string{},
+	}
+
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("map_utils.ParseDotEnv: line %d: %w", p.line, err)
+	}
+
+	return p.result, nil
+}
+
+// WriteDotEnv writes m as a .env file sorted by key. Values are double quoted
+// if they contain whitespace, quotes, "#", "$" or escape characters.
+func WriteDotEnv(w io.Writer, m map[string]string) error {
+	keys := slices.Collect(maps.Keys(m))
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if !isEnvName(k) {
+			return fmt.Errorf("map_utils.WriteDotEnv: invalid key %q", k)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s=%s\n", k, quoteDotEnv(m[k])); err != nil {
+			return fmt.Errorf("map_utils.WriteDotEnv: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type dotEnvParser struct {
+	data   string
+	pos    int
+	line   int
+	lookup func(key string) (string, bool)
+	result map[string]string
+}
+
+func (p *dotEnvParser) parse() error {
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.data) {
+			return nil
+		}
+
+		if p.peek() == '#' {
+			p.skipLine()
+			continue
+		}
+
+		key := p.readName()
+		if key == "export" && p.peek() != '=' {
+			p.skipSpaces()
+			key = p.readName()
+		}
+
+		if key == "" {
+			return fmt.Errorf("invalid variable name")
+		}
+
+		p.skipSpaces()
+		if p.peek() != '=' {
+			return fmt.Errorf("missing '=' after %q", key)
+		}
+
+		p.pos++
+		p.skipSpaces()
+
+		val, err := p.readValue()
+		if err != nil {
+			return err
+		}
+
+		p.result[key] = val
+	}
+}
+
+func (p *dotEnvParser) readValue() (string, error) {
+	switch p.peek() {
+	case '\'':
+		start := p.pos + 1
+		end := strings.IndexByte(p.data[start:], '\'')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated single quote")
+		}
+
+		val := p.data[start : start+end]
+		p.line += strings.Count(val, "\n")
+		p.pos = start + end + 1
+
+		return val, p.endOfValue()
+	case '"':
+		start := p.pos + 1
+		end := start
+		for end < len(p.data) && p.data[end] != '"' {
+			if p.data[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(p.data) {
+			return "", fmt.Errorf("unterminated double quote")
+		}
+
+		raw := p.data[start:end]
+		p.line += strings.Count(raw, "\n")
+		p.pos = end + 1
+
+		return p.interpolate(raw, true), p.endOfValue()
+	default:
+		start := p.pos
+		for p.pos < len(p.data) && p.data[p.pos] != '\n' {
+			if p.data[p.pos] == '#' && p.pos > start && isSpace(p.data[p.pos-1]) {
+				break
+			}
+			p.pos++
+		}
+
+		val := strings.TrimSpace(p.data[start:p.pos])
+		p.skipLine()
+
+		return p.interpolate(val, false), nil
+	}
+}
+
+func (p *dotEnvParser) endOfValue() error {
+	p.skipSpaces()
+	if p.peek() == '\r' {
+		p.pos++
+	}
+
+	switch p.peek() {
+	case 0, '\n':
+	case '#':
+	default:
+		return fmt.Errorf("unexpected text after quoted value")
+	}
+
+	p.skipLine()
+	return nil
+}
+
+// interpolate expands the variable references in s. An escaped "\$" is kept as
+// a dollar sign. With escapes set, the backslash escapes of double quoted values
+// are resolved in the same pass.
+func (p *dotEnvParser) interpolate(s string, escapes bool) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && (escapes || s[i+1] == '$'):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(s[i])
+			}
+		case s[i] == '$' && i+1 < len(s) && s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				sb.WriteString(s[i:])
+				return sb.String()
+			}
+
+			sb.WriteString(p.resolve(s[i+2 : i+2+end]))
+			i += end + 2
+		case s[i] == '$':
+			j := i + 1
+			for j < len(s) && isEnvNameChar(s[j], j == i+1) {
+				j++
+			}
+
+			if j == i+1 {
+				sb.WriteByte('$')
+				continue
+			}
+
+			sb.WriteString(p.resolve(s[i+1 : j]))
+			i = j - 1
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+
+	return sb.String()
+}
+
+func (p *dotEnvParser) resolve(name string) string {
+	name, def, hasDef := strings.Cut(name, ":-")
+
+	if val, ok := p.result[name]; ok && (val != "" || !hasDef) {
+		return val
+	}
+
+	if p.lookup != nil {
+		if val, ok := p.lookup(name); ok && (val != "" || !hasDef) {
+			return val
+		}
+	}
+
+	return def
+}
+
+func (p *dotEnvParser) readName() string {
+	start := p.pos
+	for p.pos < len(p.data) && isEnvNameChar(p.data[p.pos], p.pos == start) {
+		p.pos++
+	}
+
+	return p.data[start:p.pos]
+}
+
+func (p *dotEnvParser) peek() byte {
+	if p.pos >= len(p.data) {
+		return 0
+	}
+
+	return p.data[p.pos]
+}
+
+func (p *dotEnvParser) skipSpaces() {
+	for p.pos < len(p.data) && (p.data[p.pos] == ' ' || p.data[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *dotEnvParser) skipBlank() {
+	for p.pos < len(p.data) && isSpace(p.data[p.pos]) {
+		if p.data[p.pos] == '\n' {
+			p.line++
+		}
+		p.pos++
+	}
+}
+
+func (p *dotEnvParser) skipLine() {
+	end := strings.IndexByte(p.data[p.pos:], '\n')
+	if end < 0 {
+		p.pos = len(p.data)
+		return
+	}
+
+	p.pos += end + 1
+	p.line++
+}
+
+func quoteDotEnv(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\r\n\"'#$\\`") {
+		return s
+	}
+
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func isEnvName(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		if !isEnvNameChar(s[i], i == 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isEnvNameChar(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
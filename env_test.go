@@ -0,0 +1,153 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestFromEnviron(t *testing.T) {
+	t.Run("convert entries", func(t *testing.T) {
+		result := map_utils.FromEnviron([]string{"A=1", "B=x=y", "C=", "INVALID", "=C:=C:\\"})
+		assert.Equal(t, map[string]string{"A": "1", "B": "x=y", "C": "", "=C:": "C:\\"}, result)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		env := []string{"A=1", "B=2", "PATH=/bin:/usr/bin"}
+		assert.Equal(t, env, map_utils.ToEnviron(map_utils.FromEnviron(env)))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, map_utils.FromEnviron(nil))
+		assert.Empty(t, map_utils.ToEnviron(nil))
+	})
+}
+
+func TestEnvPrefix(t *testing.T) {
+	env := map[string]string{
+		"APP_NAME":     "demo",
+		"APP_DB__HOST": "localhost",
+		"APP_DB__PORT": "5432",
+		"HOME":         "/root",
+		"APP_":         "ignored",
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		result, err := map_utils.EnvPrefix(env, "APP_", "__")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"NAME": "demo",
+			"DB":   map[string]any{"HOST": "localhost", "PORT": "5432"},
+		}, result)
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		result, err := map_utils.EnvPrefix(env, "APP_", "")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"NAME": "demo", "DB__HOST": "localhost", "DB__PORT": "5432"}, result)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err := map_utils.EnvPrefix(map[string]string{"APP_DB": "x", "APP_DB_HOST": "y"}, "APP_", "_")
+		assert.EqualError(t, err, `map_utils.EnvPrefix: key "DB_HOST" conflicts with "DB"`)
+	})
+}
+
+func TestParseDotEnv(t *testing.T) {
+	lookup := func(key string) (string, bool) {
+		if key == "HOME" {
+			return "/home/app", true
+		}
+		return "", false
+	}
+
+	t.Run("parse file", func(t *testing.T) {
+		input := `# comment
+export NAME=demo
+EMPTY=
+SPACED = hello world   # trailing comment
+HASH=a#b
+SINGLE='literal $NAME \n'
+DOUBLE="line1\nline2 \"quoted\" ${NAME}"
+MULTI="first
+second"
+DATA=$HOME/data
+DEFAULT=${MISSING:-fallback}
+ESCAPED="\$NAME"
+`
+		result, err := map_utils.ParseDotEnv(strings.NewReader(input), lookup)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"NAME":    "demo",
+			"EMPTY":   "",
+			"SPACED":  "hello world",
+			"HASH":    "a#b",
+			"SINGLE":  `literal $NAME \n`,
+			"DOUBLE":  "line1\nline2 \"quoted\" demo",
+			"MULTI":   "first\nsecond",
+			"DATA":    "/home/app/data",
+			"DEFAULT": "fallback",
+			"ESCAPED": "$NAME",
+		}, result)
+	})
+
+	t.Run("escaped backslash before variable", func(t *testing.T) {
+		result, err := map_utils.ParseDotEnv(strings.NewReader(`A="a\\$HOME"`+"\n"+`B=b\$HOME`+"\n"), lookup)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"A": `a\/home/app`, "B": "b$HOME"}, result)
+	})
+
+	t.Run("crlf", func(t *testing.T) {
+		result, err := map_utils.ParseDotEnv(strings.NewReader("A=\"x y\"\r\nB=2\r\nC='z' # note\r\nD=\"end\"\r"), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"A": "x y", "B": "2", "C": "z", "D": "end"}, result)
+	})
+
+	t.Run("missing equals", func(t *testing.T) {
+		_, err := map_utils.ParseDotEnv(strings.NewReader("A=1\nB\n"), nil)
+		assert.EqualError(t, err, `map_utils.ParseDotEnv: line 2: missing '=' after "B"`)
+	})
+
+	t.Run("unterminated quote", func(t *testing.T) {
+		_, err := map_utils.ParseDotEnv(strings.NewReader("A=\"1\n"), nil)
+		assert.ErrorContains(t, err, "unterminated double quote")
+	})
+
+	t.Run("text after quote", func(t *testing.T) {
+		_, err := map_utils.ParseDotEnv(strings.NewReader("A='1' 2\n"), nil)
+		assert.EqualError(t, err, "map_utils.ParseDotEnv: line 1: unexpected text after quoted value")
+	})
+}
+
+func TestWriteDotEnv(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		m := map[string]string{
+			"NAME":    "demo",
+			"EMPTY":   "",
+			"SPACED":  "hello world",
+			"SPECIAL": "a \"b\" $c #d\ne\\f",
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(t, map_utils.WriteDotEnv(&buf, m))
+		assert.Equal(t, "EMPTY=\"\"\nNAME=demo\nSPACED=\"hello world\"\nSPECIAL=\"a \\\"b\\\" \\$c #d\\ne\\\\f\"\n", buf.String())
+
+		result, err := map_utils.ParseDotEnv(&buf, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := map_utils.WriteDotEnv(&buf, map[string]string{"1A": "x"})
+		assert.EqualError(t, err, `map_utils.WriteDotEnv: invalid key "1A"`)
+	})
+}
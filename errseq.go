@@ -0,0 +1,201 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+)
+
+// ErrSeq2 is a lazy sequence of key/value pairs that can fail. Iteration
+// stops at the first error, which is returned by the call.
+type ErrSeq2[K any, V any] func(yield func(K, V) bool) error
+
+// ErrSeq is the single value variant of ErrSeq2.
+type ErrSeq[T any] func(yield func(T) bool) error
+
+// ToErrSeq2 wraps a sequence that never fails.
+func ToErrSeq2[K any, V any](m iter.Seq2[K, V]) ErrSeq2[K, V] {
+	return func(yield func(K, V) bool) error {
+		m(yield)
+		return nil
+	}
+}
+
+// Seq returns s as iter.Seq2. The error that stopped the iteration, if any,
+// is stored in err.
+func (s ErrSeq2[K, V]) Seq(err *error) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		*err = s(yield)
+	}
+}
+
+// Seq returns s as iter.Seq. The error that stopped the iteration, if any,
+// is stored in err.
+func (s ErrSeq[T]) Seq(err *error) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		*err = s(yield)
+	}
+}
+
+// CollectErrSeq2 collects s into a map and returns the first error.
+func CollectErrSeq2[K comparable, V any](s ErrSeq2[K, V]) (map[K]V, error) {
+	result := map[K]V{}
+
+	err := s(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CollectErrSeq collects s into a slice and returns the first error.
+func CollectErrSeq[T any](s ErrSeq[T]) ([]T, error) {
+	result := []T{}
+
+	err := s(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func RemapFuncErrSeq[K1 any, V1 any, K2 any, V2 any](m ErrSeq2[K1, V1], f func(key K1, val V1) (K2, V2, error)) ErrSeq2[K2, V2] {
+	return func(yield func(K2, V2) bool) error {
+		var ferr error
+
+		err := m(func(k K1, v V1) bool {
+			k2, v2, err := f(k, v)
+			if err != nil {
+				ferr = err
+				return false
+			}
+
+			return yield(k2, v2)
+		})
+		if ferr != nil {
+			return ferr
+		}
+
+		return err
+	}
+}
+
+func FilterFuncErrSeq[K any, V any](m ErrSeq2[K, V], f func(key K, val V) (bool, error)) ErrSeq2[K, V] {
+	return func(yield func(K, V) bool) error {
+		var ferr error
+
+		err := m(func(k K, v V) bool {
+			ok, err := f(k, v)
+			if err != nil {
+				ferr = err
+				return false
+			}
+
+			return !ok || yield(k, v)
+		})
+		if ferr != nil {
+			return ferr
+		}
+
+		return err
+	}
+}
+
+func WeightFuncErrSeq[K any, V any, S cmp.Ordered](m ErrSeq2[K, V], f func(key K, val V) (S, error)) ErrSeq[S] {
+	return func(yield func(S) bool) error {
+		var ferr error
+
+		err := m(func(k K, v V) bool {
+			s, err := f(k, v)
+			if err != nil {
+				ferr = err
+				return false
+			}
+
+			return yield(s)
+		})
+		if ferr != nil {
+			return ferr
+		}
+
+		return err
+	}
+}
+
+func SliceFuncErrSeq[K any, V any, R any](m ErrSeq2[K, V], f func(key K, val V) (*R, error)) ErrSeq[R] {
+	return func(yield func(R) bool) error {
+		var ferr error
+
+		err := m(func(k K, v V) bool {
+			val, err := f(k, v)
+			if err != nil {
+				ferr = err
+				return false
+			}
+
+			return val == nil || yield(*val)
+		})
+		if ferr != nil {
+			return ferr
+		}
+
+		return err
+	}
+}
+
+func FlattenErrSeq[K any, V any](m ErrSeq2[K, V]) ErrSeq[any] {
+	return func(yield func(any) bool) error {
+		return m(func(k K, v V) bool {
+			return yield(k) && yield(v)
+		})
+	}
+}
+
+// TryRemap works like Remap but returns the first error instead of panicking.
+func TryRemap[K1 comparable, V1 any, K2 comparable, V2 any](m map[K1]V1, f func(key K1, val V1) (K2, V2, error)) (map[K2]V2, error) {
+	return CollectErrSeq2(RemapFuncErrSeq(ToErrSeq2(maps.All(m)), f))
+}
+
+// TryConvert works like Convert but returns the first error instead of panicking.
+func TryConvert[K comparable, V1 any, V2 any](m map[K]V1, f func(key K, val V1) (V2, error)) (map[K]V2, error) {
+	return TryRemap(m, func(key K, val V1) (K, V2, error) {
+		val2, err := f(key, val)
+		return key, val2, err
+	})
+}
+
+// TrySlice works like Slice but returns the first error instead of panicking.
+func TrySlice[Map ~map[K]V, K comparable, V any, S any](m Map, f func(key K, val V) (*S, error)) ([]S, error) {
+	return CollectErrSeq(SliceFuncErrSeq(ToErrSeq2(maps.All(m)), f))
+}
+
+// mustSeq2 turns s into iter.Seq2 that panics on errors.
+func mustSeq2[K any, V any](s ErrSeq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if err := s(yield); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// mustSeq turns s into iter.Seq that panics on errors.
+func mustSeq[T any](s ErrSeq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if err := s(yield); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+var errNegative = errors.New("negative value")
+
+func TestErrSeq2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	t.Run("remap and collect", func(t *testing.T) {
+		seq := map_utils.RemapFuncErrSeq(map_utils.ToErrSeq2(maps.All(m)), func(k string, v int) (int, string, error) {
+			return v, k, nil
+		})
+
+		result, err := map_utils.CollectErrSeq2(seq)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "a", 2: "b", 3: "c"}, result)
+	})
+
+	t.Run("first error stops iteration", func(t *testing.T) {
+		count := 0
+		seq := map_utils.RemapFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(map[string]int{"a": 1, "b": -1, "c": 3})), func(k string, v int) (string, int, error) {
+			count++
+			if v < 0 {
+				return "", 0, errNegative
+			}
+			return k, v, nil
+		})
+
+		result, err := map_utils.CollectErrSeq2(seq)
+		assert.ErrorIs(t, err, errNegative)
+		assert.Nil(t, result)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("errors propagate through chained sequencers", func(t *testing.T) {
+		parsed := map_utils.RemapFuncErrSeq(map_utils.ToErrSeq2(maps.All(map[string]string{"a": "1", "b": "x"})), func(k string, v string) (string, int, error) {
+			i, err := strconv.Atoi(v)
+			return k, i, err
+		})
+
+		filtered := map_utils.FilterFuncErrSeq(parsed, func(k string, v int) (bool, error) {
+			return v > 0, nil
+		})
+
+		_, err := map_utils.CollectErrSeq2(filtered)
+		assert.EqualError(t, err, `strconv.Atoi: parsing "x": invalid syntax`)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		seq := map_utils.FilterFuncErrSeq(map_utils.ToErrSeq2(maps.All(m)), func(k string, v int) (bool, error) {
+			return v != 2, nil
+		})
+
+		result, err := map_utils.CollectErrSeq2(seq)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "c": 3}, result)
+
+		_, err = map_utils.CollectErrSeq2(map_utils.FilterFuncErrSeq(map_utils.ToErrSeq2(maps.All(m)), func(k string, v int) (bool, error) {
+			return false, errNegative
+		}))
+		assert.ErrorIs(t, err, errNegative)
+	})
+
+	t.Run("weight", func(t *testing.T) {
+		result, err := map_utils.CollectErrSeq(map_utils.WeightFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (int, error) {
+			return v * 10, nil
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{10, 20, 30}, result)
+
+		_, err = map_utils.CollectErrSeq(map_utils.WeightFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (int, error) {
+			return 0, errNegative
+		}))
+		assert.ErrorIs(t, err, errNegative)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		result, err := map_utils.CollectErrSeq(map_utils.SliceFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (*string, error) {
+			if v == 2 {
+				return nil, nil
+			}
+			return &k, nil
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "c"}, result)
+	})
+
+	t.Run("flatten", func(t *testing.T) {
+		result, err := map_utils.CollectErrSeq(map_utils.FlattenErrSeq(map_utils.ToErrSeq2(sortedSeq(map[string]int{"a": 1, "b": 2}))))
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"a", 1, "b", 2}, result)
+	})
+
+	t.Run("range over seq with error", func(t *testing.T) {
+		seq := map_utils.RemapFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (string, int, error) {
+			if v == 3 {
+				return "", 0, errNegative
+			}
+			return k, v, nil
+		})
+
+		var err error
+		var keys []string
+		for k := range seq.Seq(&err) {
+			keys = append(keys, k)
+		}
+
+		assert.ErrorIs(t, err, errNegative)
+		assert.Equal(t, []string{"a", "b"}, keys)
+	})
+
+	t.Run("range over single value seq", func(t *testing.T) {
+		seq := map_utils.WeightFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (int, error) {
+			return v, nil
+		})
+
+		var err error
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(seq.Seq(&err)))
+		assert.NoError(t, err)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		count := 0
+		seq := map_utils.RemapFuncErrSeq(map_utils.ToErrSeq2(sortedSeq(m)), func(k string, v int) (string, int, error) {
+			count++
+			return k, v, nil
+		})
+
+		err := seq(func(k string, v int) bool {
+			return false
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestTryFunctions(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("try remap", func(t *testing.T) {
+		result, err := map_utils.TryRemap(m, func(k string, v int) (int, string, error) {
+			return v, k, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "a", 2: "b"}, result)
+
+		_, err = map_utils.TryRemap(m, func(k string, v int) (int, string, error) {
+			return 0, "", errNegative
+		})
+		assert.ErrorIs(t, err, errNegative)
+	})
+
+	t.Run("try convert", func(t *testing.T) {
+		result, err := map_utils.TryConvert(m, func(k string, v int) (string, error) {
+			return fmt.Sprint(v), nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "1", "b": "2"}, result)
+	})
+
+	t.Run("try slice", func(t *testing.T) {
+		_, err := map_utils.TrySlice(m, func(k string, v int) (*int, error) {
+			return nil, errNegative
+		})
+		assert.ErrorIs(t, err, errNegative)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// FromFlat is the inverse of Flatten. It reads alternating keys and values
+// like slog arguments or "k v k v" command line input. Items are converted
+// to K and V like in ToStruct, so WeaklyTyped allows parsing strings.
+func FromFlat[K comparable, V any](items []any, opts ...DecodeOption) (map[K]V, error) {
+	if len(items)%2 != 0 {
+		return nil, fmt.Errorf("map_utils.FromFlat: odd number of items: %d", len(items))
+	}
+
+	result, err := CollectErrSeq2(KVErrSeq[K, V](slices.Values(items), opts...))
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.FromFlat: %w", err)
+	}
+
+	return result, nil
+}
+
+// FromKVSeq collects a sequence of alternating keys and values into a map.
+func FromKVSeq[K comparable, V any](s iter.Seq[any], opts ...DecodeOption) (map[K]V, error) {
+	result, err := CollectErrSeq2(KVErrSeq[K, V](s, opts...))
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.FromKVSeq: %w", err)
+	}
+
+	return result, nil
+}
+
+// KVErrSeq pairs up a sequence of alternating keys and values. It fails on
+// items that cannot be converted and on a trailing key without value.
+func KVErrSeq[K any, V any](s iter.Seq[any], opts ...DecodeOption) ErrSeq2[K, V] {
+	return func(yield func(K, V) bool) error {
+		d := newDecoder(opts...)
+
+		var (
+			key    K
+			hasKey bool
+			index  int
+			err    error
+		)
+
+		for item := range s {
+			path := fmt.Sprintf("[%d]", index)
+			index++
+
+			if !hasKey {
+				key = *new(K)
+				if err = d.decode(path, item, reflect.ValueOf(&key).Elem()); err != nil {
+					return err
+				}
+
+				hasKey = true
+				continue
+			}
+
+			var val V
+			if err = d.decode(path, item, reflect.ValueOf(&val).Elem()); err != nil {
+				return err
+			}
+
+			hasKey = false
+			if !yield(key, val) {
+				return nil
+			}
+		}
+
+		if hasKey {
+			return fmt.Errorf("missing value for key %v", key)
+		}
+
+		return nil
+	}
+}
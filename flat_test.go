@@ -0,0 +1,85 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestFromFlat(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		m := map[string]int{"a": 1, "b": 2, "c": 3}
+		result, err := map_utils.FromFlat[string, int](map_utils.Flatten(m))
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+	})
+
+	t.Run("slog style arguments", func(t *testing.T) {
+		result, err := map_utils.FromFlat[string, any]([]any{"user", "bob", "id", 7, "admin", true})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"user": "bob", "id": 7, "admin": true}, result)
+	})
+
+	t.Run("command line input", func(t *testing.T) {
+		args := []string{"1", "true", "2", "false"}
+		items := make([]any, len(args))
+		for i, a := range args {
+			items[i] = a
+		}
+
+		result, err := map_utils.FromFlat[int, bool](items, map_utils.WeaklyTyped())
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]bool{1: true, 2: false}, result)
+	})
+
+	t.Run("odd length", func(t *testing.T) {
+		_, err := map_utils.FromFlat[string, int]([]any{"a", 1, "b"})
+		assert.EqualError(t, err, "map_utils.FromFlat: odd number of items: 3")
+	})
+
+	t.Run("wrong key type", func(t *testing.T) {
+		_, err := map_utils.FromFlat[string, int]([]any{"a", 1, 2, 3})
+		assert.EqualError(t, err, "map_utils.FromFlat: [2]: cannot convert int to string")
+	})
+
+	t.Run("wrong value type", func(t *testing.T) {
+		_, err := map_utils.FromFlat[string, int]([]any{"a", "x"})
+		assert.EqualError(t, err, "map_utils.FromFlat: [1]: cannot convert string to int")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result, err := map_utils.FromFlat[string, int](nil)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestFromKVSeq(t *testing.T) {
+	t.Run("collect", func(t *testing.T) {
+		result, err := map_utils.FromKVSeq[string, string](slices.Values([]any{"a", "1", "b", "2"}))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "1", "b": "2"}, result)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		_, err := map_utils.FromKVSeq[string, string](slices.Values([]any{"a", "1", "b"}))
+		assert.EqualError(t, err, "map_utils.FromKVSeq: missing value for key b")
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		count := 0
+		err := map_utils.KVErrSeq[string, int](slices.Values([]any{"a", 1, "b", 2}))(func(string, int) bool {
+			count++
+			return false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Frequencies counts how often each value occurs in s.
+func Frequencies[T comparable](s iter.Seq[T]) map[T]int {
+	return FrequenciesBy(s, func(val T) T { return val })
+}
+
+// FrequenciesBy counts the values of s grouped by the key returned by f.
+func FrequenciesBy[T any, K comparable](s iter.Seq[T], f func(val T) K) map[K]int {
+	result := map[K]int{}
+	for v := range s {
+		result[f(v)]++
+	}
+
+	return result
+}
+
+// Histogram counts the values of s in buckets separated by the sorted bounds.
+// Bucket 0 holds values below bounds[0], bucket i values in
+// [bounds[i-1], bounds[i]) and bucket len(bounds) values from the last bound on.
+// Every bucket is present in the result, even if empty.
+func Histogram[T cmp.Ordered](s iter.Seq[T], bounds []T) (map[int]int, error) {
+	if !slices.IsSorted(bounds) {
+		return nil, fmt.Errorf("map_utils.Histogram: bounds are not sorted")
+	}
+
+	result := make(map[int]int, len(bounds)+1)
+	for i := range len(bounds) + 1 {
+		result[i] = 0
+	}
+
+	for v := range s {
+		i, found := slices.BinarySearch(bounds, v)
+		if found {
+			// a value equal to a bound belongs to the bucket starting there
+			for i < len(bounds) && bounds[i] == v {
+				i++
+			}
+		}
+
+		result[i]++
+	}
+
+	return result, nil
+}
+
+// BucketLabel describes bucket i of a Histogram with bounds, e.g. "<10", "[10,20)" or ">=20".
+func BucketLabel[T cmp.Ordered](bounds []T, i int) string {
+	switch {
+	case len(bounds) == 0:
+		return "*"
+	case i <= 0:
+		return fmt.Sprintf("<%v", bounds[0])
+	case i >= len(bounds):
+		return fmt.Sprintf(">=%v", bounds[len(bounds)-1])
+	default:
+		return fmt.Sprintf("[%v,%v)", bounds[i-1], bounds[i])
+	}
+}
+
+// Normalize returns the share of each value in the total of all values.
+// All shares are 0 if the total is 0.
+func Normalize[K comparable, N Number](m map[K]N) map[K]float64 {
+	total := 0.0
+	for _, v := range m {
+		total += float64(v)
+	}
+
+	result := make(map[K]float64, len(m))
+	for k, v := range m {
+		if total == 0 {
+			result[k] = 0
+		} else {
+			result[k] = float64(v) / total
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestFrequencies(t *testing.T) {
+	words := strings.Fields("the cat and the dog and the bird")
+
+	t.Run("count words", func(t *testing.T) {
+		result := map_utils.Frequencies(slices.Values(words))
+		assert.Equal(t, map[string]int{"the": 3, "and": 2, "cat": 1, "dog": 1, "bird": 1}, result)
+	})
+
+	t.Run("flows into join and top n", func(t *testing.T) {
+		result := map_utils.Frequencies(slices.Values(words))
+		assert.Equal(t, "and=2 bird=1 cat=1 dog=1 the=3", map_utils.Join(result, " "))
+		assert.Equal(t, []map_utils.Entry[string, int]{{Key: "the", Value: 3}, {Key: "and", Value: 2}}, map_utils.TopN(result, 2, cmp.Compare[int]))
+	})
+
+	t.Run("by key function", func(t *testing.T) {
+		result := map_utils.FrequenciesBy(slices.Values(words), func(w string) int { return len(w) })
+		assert.Equal(t, map[int]int{3: 7, 4: 1}, result)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, map_utils.Frequencies(slices.Values([]int{})))
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	bounds := []int{10, 20, 30}
+
+	t.Run("buckets", func(t *testing.T) {
+		result, err := map_utils.Histogram(slices.Values([]int{1, 10, 15, 20, 29, 30, 100}), bounds)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]int{0: 1, 1: 2, 2: 2, 3: 2}, result)
+		assert.Equal(t, 7, map_utils.Summarize(result, func(k, v int) int { return v }))
+	})
+
+	t.Run("empty buckets are present", func(t *testing.T) {
+		result, err := map_utils.Histogram(slices.Values([]float64{}), []float64{0.5})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]int{0: 0, 1: 0}, result)
+	})
+
+	t.Run("unsorted bounds", func(t *testing.T) {
+		_, err := map_utils.Histogram(slices.Values([]int{1}), []int{2, 1})
+		assert.EqualError(t, err, "map_utils.Histogram: bounds are not sorted")
+	})
+
+	t.Run("labels", func(t *testing.T) {
+		assert.Equal(t, "<10", map_utils.BucketLabel(bounds, 0))
+		assert.Equal(t, "[10,20)", map_utils.BucketLabel(bounds, 1))
+		assert.Equal(t, ">=30", map_utils.BucketLabel(bounds, 3))
+		assert.Equal(t, "*", map_utils.BucketLabel([]int{}, 0))
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("proportions", func(t *testing.T) {
+		result := map_utils.Normalize(map[string]int{"a": 1, "b": 3})
+		assert.Equal(t, map[string]float64{"a": 0.25, "b": 0.75}, result)
+	})
+
+	t.Run("zero total", func(t *testing.T) {
+		assert.Equal(t, map[string]float64{"a": 0}, map_utils.Normalize(map[string]int{"a": 0}))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, map_utils.Normalize(map[string]float64{}))
+	})
+}
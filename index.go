@@ -0,0 +1,103 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// DuplicatePolicy decides which element IndexBy keeps for a duplicate key.
+type DuplicatePolicy int
+
+const (
+	// LastWins keeps the last value for a duplicate key.
+	LastWins DuplicatePolicy = iota
+	// FirstWins keeps the first value for a duplicate key.
+	FirstWins
+	// ErrorOnDuplicate fails on the first duplicate key.
+	ErrorOnDuplicate
+)
+
+// KeyBy builds a lookup table of the elements of s by the key returned by f.
+// Later elements overwrite earlier ones with the same key.
+func KeyBy[T any, K comparable](s []T, f func(val T) K) map[K]T {
+	return KeyBySeq(slices.Values(s), f)
+}
+
+func KeyBySeq[T any, K comparable](s iter.Seq[T], f func(val T) K) map[K]T {
+	return AssociateSeq(s, func(val T) (K, T) {
+		return f(val), val
+	})
+}
+
+// Associate builds a map from the key/value pairs returned by f.
+// Later pairs overwrite earlier ones with the same key.
+func Associate[T any, K comparable, V any](s []T, f func(val T) (K, V)) map[K]V {
+	return AssociateSeq(slices.Values(s), f)
+}
+
+func AssociateSeq[T any, K comparable, V any](s iter.Seq[T], f func(val T) (K, V)) map[K]V {
+	result := map[K]V{}
+	for v := range s {
+		key, val := f(v)
+		result[key] = val
+	}
+
+	return result
+}
+
+// IndexBy works like KeyBy but resolves duplicate keys according to policy.
+func IndexBy[T any, K comparable](s []T, f func(val T) K, policy DuplicatePolicy) (map[K]T, error) {
+	return IndexBySeq(slices.Values(s), f, policy)
+}
+
+func IndexBySeq[T any, K comparable](s iter.Seq[T], f func(val T) K, policy DuplicatePolicy) (map[K]T, error) {
+	result, err := IndexByFuncSeq(s, f, func(key K, existing T, val T) (T, error) {
+		switch policy {
+		case FirstWins:
+			return existing, nil
+		case ErrorOnDuplicate:
+			return existing, fmt.Errorf("duplicate key %v", key)
+		default:
+			return val, nil
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.IndexBy: %w", err)
+	}
+
+	return result, nil
+}
+
+// IndexByFunc works like KeyBy but calls merge to combine the existing and
+// the new element for a duplicate key.
+func IndexByFunc[T any, K comparable](s []T, f func(val T) K, merge func(key K, existing T, val T) (T, error)) (map[K]T, error) {
+	return IndexByFuncSeq(slices.Values(s), f, merge)
+}
+
+func IndexByFuncSeq[T any, K comparable](s iter.Seq[T], f func(val T) K, merge func(key K, existing T, val T) (T, error)) (map[K]T, error) {
+	result := map[K]T{}
+
+	for v := range s {
+		key := f(v)
+
+		if existing, ok := result[key]; ok {
+			merged, err := merge(key, existing, v)
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = merged
+			continue
+		}
+
+		result[key] = v
+	}
+
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+type testUser struct {
+	ID    int
+	Name  string
+	Roles []string
+}
+
+var testUsers = []testUser{
+	{ID: 1, Name: "bob", Roles: []string{"admin"}},
+	{ID: 2, Name: "alice", Roles: []string{"user"}},
+	{ID: 1, Name: "bobby", Roles: []string{"user"}},
+}
+
+func TestKeyBy(t *testing.T) {
+	t.Run("last wins", func(t *testing.T) {
+		result := map_utils.KeyBy(testUsers, func(u testUser) int { return u.ID })
+		assert.Equal(t, map[int]testUser{1: testUsers[2], 2: testUsers[1]}, result)
+	})
+
+	t.Run("seq", func(t *testing.T) {
+		result := map_utils.KeyBySeq(slices.Values(testUsers), func(u testUser) string { return u.Name })
+		assert.Len(t, result, 3)
+		assert.Equal(t, 2, result["alice"].ID)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, map_utils.KeyBy([]testUser{}, func(u testUser) int { return u.ID }))
+	})
+}
+
+func TestAssociate(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		result := map_utils.Associate(testUsers, func(u testUser) (string, int) { return u.Name, u.ID })
+		assert.Equal(t, map[string]int{"bob": 1, "alice": 2, "bobby": 1}, result)
+	})
+
+	t.Run("seq", func(t *testing.T) {
+		result := map_utils.AssociateSeq(slices.Values([]string{"a", "bb"}), func(s string) (string, int) { return s, len(s) })
+		assert.Equal(t, map[string]int{"a": 1, "bb": 2}, result)
+	})
+}
+
+func TestIndexBy(t *testing.T) {
+	id := func(u testUser) int { return u.ID }
+
+	t.Run("first wins", func(t *testing.T) {
+		result, err := map_utils.IndexBy(testUsers, id, map_utils.FirstWins)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", result[1].Name)
+	})
+
+	t.Run("last wins", func(t *testing.T) {
+		result, err := map_utils.IndexBy(testUsers, id, map_utils.LastWins)
+		assert.NoError(t, err)
+		assert.Equal(t, "bobby", result[1].Name)
+	})
+
+	t.Run("error on duplicate", func(t *testing.T) {
+		_, err := map_utils.IndexBy(testUsers, id, map_utils.ErrorOnDuplicate)
+		assert.EqualError(t, err, "map_utils.IndexBy: duplicate key 1")
+
+		result, err := map_utils.IndexBySeq(slices.Values(testUsers[:2]), id, map_utils.ErrorOnDuplicate)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		result, err := map_utils.IndexByFunc(testUsers, id, func(key int, existing testUser, val testUser) (testUser, error) {
+			existing.Roles = append(slices.Clone(existing.Roles), val.Roles...)
+			return existing, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, testUser{ID: 1, Name: "bob", Roles: []string{"admin", "user"}}, result[1])
+	})
+
+	t.Run("merge error", func(t *testing.T) {
+		_, err := map_utils.IndexByFuncSeq(slices.Values(testUsers), id, func(key int, existing testUser, val testUser) (testUser, error) {
+			return existing, errors.New("conflict")
+		})
+		assert.EqualError(t, err, "conflict")
+	})
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"encoding"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type QuoteMode int
+
+const (
+	// QuoteAuto quotes only when needed to read the output back with ParseJoined.
+	QuoteAuto QuoteMode = iota
+	// QuoteSpaces additionally quotes values containing whitespace.
+	QuoteSpaces
+	// QuoteAlways quotes every value.
+	QuoteAlways
+	// QuoteNever writes keys and values unchanged.
+	QuoteNever
+)
+
+type JoinOptions struct {
+	// Separator is written between entries.
+	Separator string
+	// KeyValueSeparator is written between key and value, "=" if empty.
+	KeyValueSeparator string
+	// FormatKey formats a key, fmt.Sprint if nil.
+	FormatKey func(key any) string
+	// FormatValue formats a value, fmt.Sprint if nil.
+	FormatValue func(key any, val any) string
+	Quote       QuoteMode
+	Prefix      string
+	Suffix      string
+	// MaxValueLength truncates longer values to this number of runes
+	// followed by "...". Zero means no limit.
+	MaxValueLength int
+}
+
+// JoinWith returns the entries of m sorted by key and formatted according to opts.
+func JoinWith[K cmp.Ordered, V any](m map[K]V, opts JoinOptions) string {
+	kvSep := opts.KeyValueSeparator
+	if kvSep == "" {
+		kvSep = "="
+	}
+
+	keys := slices.Collect(maps.Keys(m))
+	slices.Sort(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, k := range keys {
+		var key, val string
+
+		if opts.FormatKey != nil {
+			key = opts.FormatKey(k)
+		} else {
+			key = fmt.Sprint(k)
+		}
+
+		if opts.FormatValue != nil {
+			val = opts.FormatValue(k, m[k])
+		} else {
+			val = fmt.Sprint(m[k])
+		}
+
+		val = truncate(val, opts.MaxValueLength)
+
+		switch opts.Quote {
+		case QuoteAuto:
+			key, val = quote(key, opts.Separator, kvSep), quote(val, opts.Separator, kvSep)
+		case QuoteSpaces:
+			key = quote(key, opts.Separator, kvSep)
+			if containsSpace(val) {
+				val = strconv.Quote(val)
+			} else {
+				val = quote(val, opts.Separator, kvSep)
+			}
+		case QuoteAlways:
+			key, val = quote(key, opts.Separator, kvSep), strconv.Quote(val)
+		}
+
+		entries = append(entries, key+kvSep+val)
+	}
+
+	return opts.Prefix + strings.Join(entries, opts.Separator) + opts.Suffix
+}
+
+// ParseJoined is the inverse of Join. It splits s into entries at sep and
+// each entry into key and value at kvSep. Keys and values may be quoted Go
+// string literals, which Join emits when they contain separators, quotes or
+// non-printable characters. Nil parsers convert the text leniently to K or V.
+func ParseJoined[K comparable, V any](s string, sep string, kvSep string, parseKey func(string) (K, error), parseValue func(string) (V, error)) (map[K]V, error) {
+	if sep == "" || kvSep == "" {
+		return nil, fmt.Errorf("map_utils.ParseJoined: separators must not be empty")
+	}
+
+	if parseKey == nil {
+		parseKey = parseText[K]
+	}
+
+	if parseValue == nil {
+		parseValue = parseText[V]
+	}
+
+	result := map[K]V{}
+	if s == "" {
+		return result, nil
+	}
+
+	pos := 0
+	for {
+		rawKey, next, err := readToken(s, pos, kvSep)
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ParseJoined: %w", err)
+		}
+
+		if !strings.HasPrefix(s[next:], kvSep) {
+			return nil, fmt.Errorf("map_utils.ParseJoined: missing %q after key at offset %d", kvSep, pos)
+		}
+
+		rawVal, end, err := readToken(s, next+len(kvSep), sep)
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ParseJoined: %w", err)
+		}
+
+		key, err := parseKey(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ParseJoined: key %q: %w", rawKey, err)
+		}
+
+		val, err := parseValue(rawVal)
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.ParseJoined: value of %q: %w", rawKey, err)
+		}
+
+		result[key] = val
+
+		if end == len(s) {
+			return result, nil
+		}
+
+		if !strings.HasPrefix(s[end:], sep) {
+			return nil, fmt.Errorf("map_utils.ParseJoined: unexpected text at offset %d", end)
+		}
+
+		pos = end + len(sep)
+	}
+}
+
+// readToken reads a quoted or bare token starting at pos. A bare token ends
+// at the next delimiter or at the end of s.
+func readToken(s string, pos int, delim string) (string, int, error) {
+	if pos < len(s) && s[pos] == '"' {
+		end := pos + 1
+		for end < len(s) && s[end] != '"' {
+			if s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(s) {
+			return "", 0, fmt.Errorf("unterminated quote at offset %d", pos)
+		}
+
+		token, err := strconv.Unquote(s[pos : end+1])
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid quoted text at offset %d: %w", pos, err)
+		}
+
+		return token, end + 1, nil
+	}
+
+	end := strings.Index(s[pos:], delim)
+	if end < 0 {
+		return s[pos:], len(s), nil
+	}
+
+	return s[pos : pos+end], pos + end, nil
+}
+
+// quote returns s as a quoted Go string literal if it contains one of the
+// separators, a quote, a backslash or a non-printable rune.
+func quote(s string, seps ...string) string {
+	if slices.ContainsFunc(seps, func(sep string) bool {
+		return sep != "" && strings.Contains(s, sep)
+	}) {
+		return strconv.Quote(s)
+	}
+
+	for _, r := range s {
+		if r == '"' || r == '\\' || !strconv.IsPrint(r) {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
+
+func parseText[T any](s string) (T, error) {
+	var result T
+
+	d := newDecoder(WeaklyTyped())
+	if err := d.decode("", s, reflect.ValueOf(&result).Elem()); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// formatText returns val as text like fmt.Sprint, preferring error messages
+// and encoding.TextMarshaler output. Nil is returned as an empty string.
+func formatText(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case error:
+		return v.Error()
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+
+		return string(text)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func truncate(s string, limit int) string {
+	if limit <= 0 {
+		return s
+	}
+
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+
+	return string(runes[:limit]) + "..."
+}
+
+func containsSpace(s string) bool {
+	return strings.IndexFunc(s, unicode.IsSpace) >= 0
+}
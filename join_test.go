@@ -0,0 +1,151 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestParseJoined(t *testing.T) {
+	t.Run("parse string map", func(t *testing.T) {
+		result, err := map_utils.ParseJoined[string, string]("a=hello | b=world", " | ", "=", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "hello", "b": "world"}, result)
+	})
+
+	t.Run("parse int map", func(t *testing.T) {
+		result, err := map_utils.ParseJoined[int, int]("1=10, 2=20, 3=30", ", ", "=", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]int{1: 10, 2: 20, 3: 30}, result)
+	})
+
+	t.Run("custom parsers", func(t *testing.T) {
+		result, err := map_utils.ParseJoined("A:1;B:2", ";", ":",
+			func(s string) (string, error) { return strings.ToLower(s), nil },
+			strconv.Atoi,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, result)
+	})
+
+	t.Run("round trip with special characters", func(t *testing.T) {
+		m := map[string]string{
+			"a":     "x=1",
+			"b":     "1, 2",
+			"c=d":   `say "hi"`,
+			"e":     `back\slash`,
+			"f":     "line\nbreak",
+			"empty": "",
+		}
+
+		for _, sep := range []string{",", ", ", " ", " | ", "="} {
+			result, err := map_utils.ParseJoined[string, string](map_utils.Join(m, sep), sep, "=", nil, nil)
+			assert.NoError(t, err, sep)
+			assert.Equal(t, m, result, sep)
+		}
+	})
+
+	t.Run("empty string", func(t *testing.T) {
+		result, err := map_utils.ParseJoined[string, string]("", ",", "=", nil, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("missing key value separator", func(t *testing.T) {
+		_, err := map_utils.ParseJoined[string, string]("a=1,b", ",", "=", nil, nil)
+		assert.EqualError(t, err, `map_utils.ParseJoined: missing "=" after key at offset 4`)
+	})
+
+	t.Run("unterminated quote", func(t *testing.T) {
+		_, err := map_utils.ParseJoined[string, string](`a="1`, ",", "=", nil, nil)
+		assert.EqualError(t, err, "map_utils.ParseJoined: unterminated quote at offset 2")
+	})
+
+	t.Run("text after quote", func(t *testing.T) {
+		_, err := map_utils.ParseJoined[string, string](`a="1"x,b=2`, ",", "=", nil, nil)
+		assert.EqualError(t, err, "map_utils.ParseJoined: unexpected text at offset 5")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := map_utils.ParseJoined[string, int]("a=x", ",", "=", nil, nil)
+		assert.ErrorContains(t, err, `map_utils.ParseJoined: value of "a": cannot parse "x" as int`)
+	})
+
+	t.Run("empty separator", func(t *testing.T) {
+		_, err := map_utils.ParseJoined[string, string]("a=1", "", "=", nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestJoinWith(t *testing.T) {
+	m := map[string]any{"name": "hello world", "port": 8080, "path": "/tmp"}
+
+	t.Run("defaults", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{Separator: ","})
+		assert.Equal(t, "name=hello world,path=/tmp,port=8080", result)
+	})
+
+	t.Run("key value separator", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{Separator: "\n", KeyValueSeparator: ": "})
+		assert.Equal(t, "name: hello world\npath: /tmp\nport: 8080", result)
+
+		result = map_utils.JoinWith(map[string]string{"a": "multi word value", "b": "x: y"}, map_utils.JoinOptions{Separator: ", ", KeyValueSeparator: ": "})
+		assert.Equal(t, `a: multi word value, b: "x: y"`, result)
+	})
+
+	t.Run("quote values with spaces", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{Separator: ",", Quote: map_utils.QuoteSpaces})
+		assert.Equal(t, `name="hello world",path=/tmp,port=8080`, result)
+	})
+
+	t.Run("quote always", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{Separator: " ", Quote: map_utils.QuoteAlways})
+		assert.Equal(t, `name="hello world" path="/tmp" port="8080"`, result)
+	})
+
+	t.Run("quote never", func(t *testing.T) {
+		result := map_utils.JoinWith(map[string]string{"a": "1,2"}, map_utils.JoinOptions{Separator: ",", Quote: map_utils.QuoteNever})
+		assert.Equal(t, "a=1,2", result)
+	})
+
+	t.Run("formatters", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{
+			Separator: ", ",
+			FormatKey: func(key any) string {
+				return strings.ToUpper(key.(string))
+			},
+			FormatValue: func(key any, val any) string {
+				if key == "port" {
+					return "****"
+				}
+				return fmt.Sprint(val)
+			},
+			Quote: map_utils.QuoteSpaces,
+		})
+		assert.Equal(t, `NAME="hello world", PATH=/tmp, PORT=****`, result)
+	})
+
+	t.Run("prefix and suffix", func(t *testing.T) {
+		result := map_utils.JoinWith(map[int]int{1: 2}, map_utils.JoinOptions{Prefix: "{", Suffix: "}"})
+		assert.Equal(t, "{1=2}", result)
+	})
+
+	t.Run("truncate long values", func(t *testing.T) {
+		result := map_utils.JoinWith(m, map_utils.JoinOptions{Separator: " ", MaxValueLength: 4, Quote: map_utils.QuoteSpaces})
+		assert.Equal(t, "name=hell... path=/tmp port=8080", result)
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		result := map_utils.JoinWith(map[string]int{}, map_utils.JoinOptions{Prefix: "[", Suffix: "]"})
+		assert.Equal(t, "[]", result)
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MarshalLogfmt encodes m as a single logfmt line. Keys are sorted like in
+// Join and nested maps are written with dotted keys. Empty nested maps are
+// written as empty values.
+func MarshalLogfmt(m map[string]any) ([]byte, error) {
+	flat, err := flattenKeys(m, ".")
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.MarshalLogfmt: %w", err)
+	}
+
+	for k := range flat {
+		if k == "" || strings.ContainsFunc(k, func(r rune) bool {
+			return r <= ' ' || r == '=' || r == '"' || !strconv.IsPrint(r)
+		}) {
+			return nil, fmt.Errorf("map_utils.MarshalLogfmt: invalid key %q", k)
+		}
+	}
+
+	result := JoinWith(flat, JoinOptions{
+		Separator:   " ",
+		FormatValue: formatLogfmtValue,
+		Quote:       QuoteSpaces,
+	})
+
+	return []byte(result), nil
+}
+
+// UnmarshalLogfmt decodes all key/value pairs of data into a map. Dotted
+// keys are expanded into nested maps and values are kept as strings.
+func UnmarshalLogfmt(data []byte) (map[string]any, error) {
+	flat := map[string]string{}
+
+	d := NewLogfmtDecoder(bytes.NewReader(data))
+	for d.Next() {
+		for k, v := range d.Pairs() {
+			flat[k] = v
+		}
+	}
+
+	if err := d.Err(); err != nil {
+		return nil, fmt.Errorf("map_utils.UnmarshalLogfmt: %w", err)
+	}
+
+	result, err := expandKeys(flat, ".")
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.UnmarshalLogfmt: %w", err)
+	}
+
+	return result, nil
+}
+
+// LogfmtDecoder reads logfmt records line by line.
+type LogfmtDecoder struct {
+	scanner *bufio.Scanner
+	line    string
+	lineNo  int
+	err     error
+}
+
+func NewLogfmtDecoder(r io.Reader) *LogfmtDecoder {
+	return &LogfmtDecoder{scanner: bufio.NewScanner(r)}
+}
+
+// Next advances to the next line. It returns false at the end of the input
+// or after an error.
+func (d *LogfmtDecoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+
+	if !d.scanner.Scan() {
+		d.err = d.scanner.Err()
+		return false
+	}
+
+	d.line = d.scanner.Text()
+	d.lineNo++
+
+	return true
+}
+
+// Pairs returns the key/value pairs of the current line. A syntax error stops
+// the sequence and is reported by Err.
+func (d *LogfmtDecoder) Pairs() iter.Seq2[string, string] {
+	line, lineNo := d.line, d.lineNo
+
+	return func(yield func(string, string) bool) {
+		if err := parseLogfmt(line, yield); err != nil && d.err == nil {
+			d.err = fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+}
+
+func (d *LogfmtDecoder) Err() error {
+	return d.err
+}
+
+func parseLogfmt(line string, yield func(string, string) bool) error {
+	pos := 0
+
+	for {
+		for pos < len(line) && isLogfmtSpace(line[pos]) {
+			pos++
+		}
+
+		if pos == len(line) {
+			return nil
+		}
+
+		start := pos
+		for pos < len(line) && !isLogfmtSpace(line[pos]) && line[pos] != '=' {
+			if line[pos] == '"' {
+				return fmt.Errorf("unexpected quote in key at offset %d", pos)
+			}
+			pos++
+		}
+
+		key := line[start:pos]
+		if key == "" {
+			return fmt.Errorf("missing key at offset %d", pos)
+		}
+
+		if pos == len(line) || line[pos] != '=' {
+			if !yield(key, "") {
+				return nil
+			}
+
+			continue
+		}
+
+		pos++
+
+		var val string
+		if pos < len(line) && line[pos] == '"' {
+			var err error
+			if val, pos, err = readToken(line, pos, " "); err != nil {
+				return err
+			}
+
+			if pos < len(line) && !isLogfmtSpace(line[pos]) {
+				return fmt.Errorf("unexpected text at offset %d", pos)
+			}
+		} else {
+			start = pos
+			for pos < len(line) && !isLogfmtSpace(line[pos]) {
+				if line[pos] == '"' {
+					return fmt.Errorf("unexpected quote in value at offset %d", pos)
+				}
+				pos++
+			}
+
+			val = line[start:pos]
+		}
+
+		if !yield(key, val) {
+			return nil
+		}
+	}
+}
+
+func formatLogfmtValue(key any, val any) string {
+	// flattenKeys keeps empty nested maps, they are written as empty values
+	if _, ok := val.(map[string]any); ok {
+		return ""
+	}
+
+	return formatText(val)
+}
+
+func isLogfmtSpace(b byte) bool {
+	return b < 0x80 && unicode.IsSpace(rune(b))
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestMarshalLogfmt(t *testing.T) {
+	t.Run("simple map", func(t *testing.T) {
+		m := map[string]any{
+			"level": "info",
+			"msg":   "hello world",
+			"count": 3,
+			"ok":    true,
+			"query": "a=b",
+			"empty": nil,
+		}
+
+		data, err := map_utils.MarshalLogfmt(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `count=3 empty= level=info msg="hello world" ok=true query="a=b"`, string(data))
+	})
+
+	t.Run("nested map", func(t *testing.T) {
+		m := map[string]any{
+			"http": map[string]any{"method": "GET", "status": 200},
+			"msg":  "done",
+		}
+
+		data, err := map_utils.MarshalLogfmt(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `http.method=GET http.status=200 msg=done`, string(data))
+	})
+
+	t.Run("text marshaler and error", func(t *testing.T) {
+		m := map[string]any{
+			"at":  time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC),
+			"err": errors.New("file not found"),
+		}
+
+		data, err := map_utils.MarshalLogfmt(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `at=2026-01-18T10:00:00Z err="file not found"`, string(data))
+	})
+
+	t.Run("conflicting keys", func(t *testing.T) {
+		_, err := map_utils.MarshalLogfmt(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}})
+		assert.EqualError(t, err, `map_utils.MarshalLogfmt: duplicate key "a.b"`)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := map_utils.MarshalLogfmt(map[string]any{"a b": 1})
+		assert.EqualError(t, err, `map_utils.MarshalLogfmt: invalid key "a b"`)
+	})
+}
+
+func TestUnmarshalLogfmt(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		m := map[string]any{
+			"msg":  `say "hi"`,
+			"http": map[string]any{"method": "GET", "path": "/a b"},
+		}
+
+		data, err := map_utils.MarshalLogfmt(m)
+		assert.NoError(t, err)
+
+		result, err := map_utils.UnmarshalLogfmt(data)
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+	})
+
+	t.Run("empty nested map", func(t *testing.T) {
+		data, err := map_utils.MarshalLogfmt(map[string]any{"a": 1, "e": map[string]any{}})
+		assert.NoError(t, err)
+		assert.Equal(t, "a=1 e=", string(data))
+
+		m, err := map_utils.UnmarshalLogfmt(data)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": "1", "e": ""}, m)
+	})
+
+	t.Run("bare keys and whitespace", func(t *testing.T) {
+		result, err := map_utils.UnmarshalLogfmt([]byte("  debug   a=1\tb= "))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"debug": "", "a": "1", "b": ""}, result)
+	})
+
+	t.Run("conflicting keys", func(t *testing.T) {
+		_, err := map_utils.UnmarshalLogfmt([]byte("a=1 a.b=2"))
+		assert.EqualError(t, err, `map_utils.UnmarshalLogfmt: key "a.b" conflicts with "a"`)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := map_utils.UnmarshalLogfmt([]byte("a=1\nb=\"2"))
+		assert.EqualError(t, err, "map_utils.UnmarshalLogfmt: line 2: unterminated quote at offset 2")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := map_utils.UnmarshalLogfmt([]byte("=1"))
+		assert.EqualError(t, err, "map_utils.UnmarshalLogfmt: line 1: missing key at offset 0")
+	})
+}
+
+func TestLogfmtDecoder(t *testing.T) {
+	input := "level=info msg=start\nlevel=warn msg=\"disk full\" used=99\n"
+
+	t.Run("decode lines", func(t *testing.T) {
+		d := map_utils.NewLogfmtDecoder(strings.NewReader(input))
+
+		var lines []map[string]string
+		for d.Next() {
+			line := map[string]string{}
+			for k, v := range d.Pairs() {
+				line[k] = v
+			}
+			lines = append(lines, line)
+		}
+
+		assert.NoError(t, d.Err())
+		assert.Equal(t, []map[string]string{
+			{"level": "info", "msg": "start"},
+			{"level": "warn", "msg": "disk full", "used": "99"},
+		}, lines)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		d := map_utils.NewLogfmtDecoder(strings.NewReader(input))
+		assert.True(t, d.Next())
+
+		count := 0
+		for range d.Pairs() {
+			count++
+			break
+		}
+
+		assert.Equal(t, 1, count)
+		assert.NoError(t, d.Err())
+	})
+
+	t.Run("stop after error", func(t *testing.T) {
+		d := map_utils.NewLogfmtDecoder(strings.NewReader("a=\"1\"x\nb=2\n"))
+		assert.True(t, d.Next())
+
+		for range d.Pairs() {
+		}
+
+		assert.EqualError(t, d.Err(), "line 1: unexpected text at offset 5")
+		assert.False(t, d.Next())
+	})
+}
@@ -40,17 +40,21 @@ func CountFunc[K comparable, V any](m map[K]V, f func(key K, val V) bool) int {
 	return cnt
 }
 
+// ExistsFunc reports whether at least one entry satisfies f.
+//
+// Deprecated: Earlier versions returned true only when no entry matched.
+// Use Any for an existence check or None for the old behavior.
 func ExistsFunc[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
-	for k, v := range m {
-		if f(k, v) {
-			return false
-		}
-	}
-
-	return true
+	return Any(m, f)
 }
 
+// ContainsKey is kept for compatibility and is equivalent to ContainsAnyKey.
 func ContainsKey[K comparable, V any](m map[K]V, keys ...K) bool {
+	return ContainsAnyKey(m, keys...)
+}
+
+// ContainsAnyKey reports whether any of the keys exists in m.
+func ContainsAnyKey[K comparable, V any](m map[K]V, keys ...K) bool {
 	for _, k := range keys {
 		if _, ok := m[k]; ok {
 			return true
@@ -59,6 +63,44 @@ func ContainsKey[K comparable, V any](m map[K]V, keys ...K) bool {
 	return false
 }
 
+// ContainsAllKeys reports whether every key exists in m. It is true if no keys are given.
+func ContainsAllKeys[K comparable, V any](m map[K]V, keys ...K) bool {
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// MissingKeys returns the keys not present in m, in the order they were given.
+func MissingKeys[K comparable, V any](m map[K]V, keys ...K) []K {
+	var missing []K
+
+	for _, k := range keys {
+		if _, ok := m[k]; !ok && !slices.Contains(missing, k) {
+			missing = append(missing, k)
+		}
+	}
+
+	return missing
+}
+
+// RequireKeys returns an error listing all keys not present in m.
+func RequireKeys[K comparable, V any](m map[K]V, keys ...K) error {
+	missing := MissingKeys(m, keys...)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(missing))
+	for i, k := range missing {
+		names[i] = fmt.Sprintf("%v", k)
+	}
+
+	return fmt.Errorf("map_utils.RequireKeys: missing keys: %s", strings.Join(names, ", "))
+}
+
 func Contains[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
 	for k, v := range m {
 		if f(k, v) {
@@ -141,18 +183,11 @@ func Summarize[K cmp.Ordered, V any, M ~map[K]V, S cmp.Ordered](m M, f func(key
 	return slice_utils.SumSeq(WeightFuncSeq(maps.All(m), f))
 }
 
+// Join returns the entries of m sorted by key as "key=value" pairs separated
+// by sep. Keys and values containing separator characters, quotes or
+// non-printable runes are quoted, so the result can be read back with ParseJoined.
 func Join[K cmp.Ordered, V any](m map[K]V, sep string) string {
-	entries := []string{}
-
-	keys := slices.Collect(maps.Keys(m))
-	slices.Sort(keys)
-
-	for _, k := range keys {
-		v := m[k]
-		entries = append(entries, fmt.Sprintf("%v=%v", k, v))
-	}
-
-	return strings.Join(entries, sep)
+	return JoinWith(m, JoinOptions{Separator: sep})
 }
 
 func Flatten[K cmp.Ordered, V any](m map[K]V) []any {
@@ -88,22 +88,20 @@ func TestCountFunc(t *testing.T) {
 }
 
 func TestExistsFunc(t *testing.T) {
-	t.Run("returns false when predicate is satisfied", func(t *testing.T) {
+	t.Run("returns true when predicate is satisfied", func(t *testing.T) {
 		m := map[int]int{1: 1, 2: 2, 3: 3}
-		// Predicate satisfied by 2
 		result := map_utils.ExistsFunc(m, func(key int, val int) bool {
 			return val == 2
 		})
-		assert.False(t, result)
+		assert.True(t, result)
 	})
 
-	t.Run("returns true when predicate is not satisfied", func(t *testing.T) {
+	t.Run("returns false when predicate is not satisfied", func(t *testing.T) {
 		m := map[int]int{1: 1, 2: 2, 3: 3}
-		// Predicate not satisfied
 		result := map_utils.ExistsFunc(m, func(key int, val int) bool {
 			return val > 5
 		})
-		assert.True(t, result)
+		assert.False(t, result)
 	})
 
 	t.Run("empty map", func(t *testing.T) {
@@ -111,7 +109,7 @@ func TestExistsFunc(t *testing.T) {
 		result := map_utils.ExistsFunc(m, func(key int, val int) bool {
 			return true
 		})
-		assert.True(t, result)
+		assert.False(t, result)
 	})
 }
 
@@ -170,6 +168,68 @@ func TestContainsKey(t *testing.T) {
 	})
 }
 
+func TestContainsAnyKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("contains one of multiple keys", func(t *testing.T) {
+		assert.True(t, map_utils.ContainsAnyKey(m, "c", "b"))
+	})
+
+	t.Run("does not contain key", func(t *testing.T) {
+		assert.False(t, map_utils.ContainsAnyKey(m, "c", "d"))
+	})
+
+	t.Run("no keys provided", func(t *testing.T) {
+		assert.False(t, map_utils.ContainsAnyKey(m))
+	})
+}
+
+func TestContainsAllKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("contains all keys", func(t *testing.T) {
+		assert.True(t, map_utils.ContainsAllKeys(m, "a", "b"))
+	})
+
+	t.Run("one key missing", func(t *testing.T) {
+		assert.False(t, map_utils.ContainsAllKeys(m, "a", "c"))
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		var nilMap map[string]int
+		assert.False(t, map_utils.ContainsAllKeys(nilMap, "a"))
+	})
+
+	t.Run("no keys provided", func(t *testing.T) {
+		assert.True(t, map_utils.ContainsAllKeys(m))
+	})
+}
+
+func TestMissingKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("some keys missing", func(t *testing.T) {
+		assert.Equal(t, []string{"d", "c"}, map_utils.MissingKeys(m, "d", "a", "c", "d"))
+	})
+
+	t.Run("no keys missing", func(t *testing.T) {
+		assert.Empty(t, map_utils.MissingKeys(m, "a", "b"))
+	})
+}
+
+func TestRequireKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	t.Run("all keys present", func(t *testing.T) {
+		assert.NoError(t, map_utils.RequireKeys(m, "a", "b"))
+	})
+
+	t.Run("keys missing", func(t *testing.T) {
+		err := map_utils.RequireKeys(m, "a", "c", "d")
+		assert.EqualError(t, err, "map_utils.RequireKeys: missing keys: c, d")
+	})
+}
+
 func TestContains(t *testing.T) {
 	m := map[int]string{1: "a", 2: "b", 3: "c"}
 
@@ -412,6 +472,22 @@ func TestJoin(t *testing.T) {
 		result := map_utils.Join(m, ", ")
 		assert.Equal(t, "a=hello", result)
 	})
+
+	t.Run("quote separators", func(t *testing.T) {
+		m := map[string]string{"a": "x=1", "b": "1,2", "c=d": "say \"hi\"", "e": "hello world"}
+		result := map_utils.Join(m, ",")
+		assert.Equal(t, `a="x=1",b="1,2","c=d"="say \"hi\"",e=hello world`, result)
+	})
+
+	t.Run("quote only whole separators", func(t *testing.T) {
+		m := map[string]string{"a": "hello world", "b": "1,2", "c": "x, y"}
+		result := map_utils.Join(m, ", ")
+		assert.Equal(t, `a=hello world, b=1,2, c="x, y"`, result)
+
+		parsed, err := map_utils.ParseJoined[string, string](result, ", ", "=", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, m, parsed)
+	})
 }
 
 func TestSlice(t *testing.T) {
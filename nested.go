@@ -0,0 +1,88 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// flattenKeys returns a flat copy of m where nested map[string]any values are
+// replaced by their entries with keys joined by sep. It fails if two entries
+// result in the same key.
+func flattenKeys(m map[string]any, sep string) (map[string]any, error) {
+	result := map[string]any{}
+
+	var walk func(prefix string, m map[string]any) error
+	walk = func(prefix string, m map[string]any) error {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			v := m[k]
+			if prefix != "" {
+				k = prefix + sep + k
+			}
+
+			if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+				if err := walk(k, nested); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if _, ok := result[k]; ok {
+				return fmt.Errorf("duplicate key %q", k)
+			}
+
+			result[k] = v
+		}
+
+		return nil
+	}
+
+	if err := walk("", m); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// expandKeys is the inverse of flattenKeys. It splits keys at sep and builds
+// nested maps. It fails if a key is both a value and the parent of another key.
+func expandKeys[V any](m map[string]V, sep string) (map[string]any, error) {
+	result := map[string]any{}
+
+	keys := slices.Collect(maps.Keys(m))
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		parts := strings.Split(key, sep)
+		node := result
+
+		for i, part := range parts[:len(parts)-1] {
+			switch child := node[part].(type) {
+			case nil:
+				next := map[string]any{}
+				node[part] = next
+				node = next
+			case map[string]any:
+				node = child
+			default:
+				return nil, fmt.Errorf("key %q conflicts with %q", key, strings.Join(parts[:i+1], sep))
+			}
+		}
+
+		last := parts[len(parts)-1]
+		if _, ok := node[last]; ok {
+			return nil, fmt.Errorf("key %q conflicts with nested keys", key)
+		}
+
+		node[last] = m[key]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Pipeline wraps an iter.Seq2 to chain lazy transformations. Intermediate
+// operations return a new Pipeline, terminal operations consume it.
+type Pipeline[K comparable, V any] struct {
+	seq iter.Seq2[K, V]
+}
+
+func NewPipeline[K comparable, V any](seq iter.Seq2[K, V]) Pipeline[K, V] {
+	return Pipeline[K, V]{seq: seq}
+}
+
+// PipelineFrom starts a pipeline over the entries of m in unspecified order.
+func PipelineFrom[K comparable, V any](m map[K]V) Pipeline[K, V] {
+	return NewPipeline(maps.All(m))
+}
+
+// All returns the underlying sequence.
+func (p Pipeline[K, V]) All() iter.Seq2[K, V] {
+	return p.seq
+}
+
+func (p Pipeline[K, V]) Filter(f func(key K, val V) bool) Pipeline[K, V] {
+	return NewPipeline(FilterSeq2(p.seq, f))
+}
+
+func (p Pipeline[K, V]) Map(f func(key K, val V) (K, V)) Pipeline[K, V] {
+	seq := p.seq
+
+	return NewPipeline(func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	})
+}
+
+func (p Pipeline[K, V]) Take(n int) Pipeline[K, V] {
+	return NewPipeline(TakeSeq2(p.seq, n))
+}
+
+func (p Pipeline[K, V]) Skip(n int) Pipeline[K, V] {
+	return NewPipeline(SkipSeq2(p.seq, n))
+}
+
+// Sorted buffers all entries and yields them ordered by compare.
+func (p Pipeline[K, V]) Sorted(compare func(k1 K, v1 V, k2 K, v2 V) int) Pipeline[K, V] {
+	seq := p.seq
+
+	return NewPipeline(func(yield func(K, V) bool) {
+		entries := slices.Collect(EntriesSeq(seq))
+
+		SortEntriesFunc(entries, func(a, b Entry[K, V]) int {
+			return compare(a.Key, a.Value, b.Key, b.Value)
+		})
+
+		for _, e := range entries {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	})
+}
+
+// Distinct drops entries whose key was already yielded.
+func (p Pipeline[K, V]) Distinct() Pipeline[K, V] {
+	seq := p.seq
+
+	return NewPipeline(func(yield func(K, V) bool) {
+		seen := map[K]struct{}{}
+
+		for k, v := range seq {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+
+			seen[k] = struct{}{}
+			if !yield(k, v) {
+				return
+			}
+		}
+	})
+}
+
+// Peek calls f for every entry passing through.
+func (p Pipeline[K, V]) Peek(f func(key K, val V)) Pipeline[K, V] {
+	seq := p.seq
+
+	return NewPipeline(func(yield func(K, V) bool) {
+		for k, v := range seq {
+			f(k, v)
+			if !yield(k, v) {
+				return
+			}
+		}
+	})
+}
+
+// Collect returns the entries as a map. Later entries overwrite earlier ones with the same key.
+func (p Pipeline[K, V]) Collect() map[K]V {
+	return maps.Collect(p.seq)
+}
+
+func (p Pipeline[K, V]) Count() int {
+	cnt := 0
+	for range p.seq {
+		cnt++
+	}
+
+	return cnt
+}
+
+func (p Pipeline[K, V]) Reduce(init V, f func(acc V, key K, val V) V) V {
+	acc := init
+	for k, v := range p.seq {
+		acc = f(acc, k, v)
+	}
+
+	return acc
+}
+
+// First returns the first entry and false if the pipeline is empty.
+func (p Pipeline[K, V]) First() (K, V, bool) {
+	for k, v := range p.seq {
+		return k, v, true
+	}
+
+	return *new(K), *new(V), false
+}
+
+// Join formats the entries like Join but keeps the pipeline order.
+func (p Pipeline[K, V]) Join(sep string) string {
+	var entries []string
+	for k, v := range p.seq {
+		entries = append(entries, quote(fmt.Sprint(k), sep, "=")+"="+quote(fmt.Sprint(v), sep, "="))
+	}
+
+	return strings.Join(entries, sep)
+}
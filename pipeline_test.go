@@ -0,0 +1,101 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"cmp"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func byKey(k1 string, v1 int, k2 string, v2 int) int {
+	return cmp.Compare(k1, k2)
+}
+
+func TestPipeline(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5}
+
+	t.Run("filter, map and collect", func(t *testing.T) {
+		result := map_utils.PipelineFrom(m).
+			Filter(func(k string, v int) bool { return v%2 == 1 }).
+			Map(func(k string, v int) (string, int) { return strings.ToUpper(k), v * 10 }).
+			Collect()
+
+		assert.Equal(t, map[string]int{"A": 10, "C": 30, "E": 50}, result)
+	})
+
+	t.Run("sorted, skip and take", func(t *testing.T) {
+		result := map_utils.PipelineFrom(m).Sorted(byKey).Skip(1).Take(2).Join(",")
+		assert.Equal(t, "b=2,c=3", result)
+	})
+
+	t.Run("sorted by value descending", func(t *testing.T) {
+		result := map_utils.PipelineFrom(m).
+			Sorted(func(k1 string, v1 int, k2 string, v2 int) int { return cmp.Compare(v2, v1) }).
+			Take(2).
+			Join(" ")
+		assert.Equal(t, "e=5 d=4", result)
+	})
+
+	t.Run("distinct", func(t *testing.T) {
+		seq := func(yield func(string, int) bool) {
+			for _, k := range []string{"a", "b", "a", "c", "b"} {
+				if !yield(k, len(k)) {
+					return
+				}
+			}
+		}
+
+		assert.Equal(t, "a=1 b=1 c=1", map_utils.NewPipeline(seq).Distinct().Join(" "))
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		var seen []string
+		map_utils.PipelineFrom(m).Sorted(byKey).Peek(func(k string, v int) { seen = append(seen, k) }).Take(2).Count()
+		assert.Equal(t, []string{"a", "b"}, seen)
+	})
+
+	t.Run("count and reduce", func(t *testing.T) {
+		p := map_utils.PipelineFrom(m).Filter(func(k string, v int) bool { return v > 2 })
+		assert.Equal(t, 3, p.Count())
+		assert.Equal(t, 12, p.Reduce(0, func(acc int, k string, v int) int { return acc + v }))
+	})
+
+	t.Run("first", func(t *testing.T) {
+		k, v, ok := map_utils.PipelineFrom(m).Sorted(byKey).First()
+		assert.True(t, ok)
+		assert.Equal(t, "a", k)
+		assert.Equal(t, 1, v)
+
+		_, _, ok = map_utils.PipelineFrom(map[string]int{}).First()
+		assert.False(t, ok)
+	})
+
+	t.Run("lazy evaluation", func(t *testing.T) {
+		count := 0
+		k, _, ok := map_utils.PipelineFrom(m).
+			Peek(func(k string, v int) { count++ }).
+			Filter(func(k string, v int) bool { return true }).
+			First()
+
+		assert.True(t, ok)
+		assert.Contains(t, m, k)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("take zero and negative skip", func(t *testing.T) {
+		assert.Equal(t, 0, map_utils.PipelineFrom(m).Take(0).Count())
+		assert.Equal(t, 5, map_utils.PipelineFrom(m).Skip(-1).Count())
+	})
+
+	t.Run("all", func(t *testing.T) {
+		assert.Equal(t, m, maps.Collect(map_utils.PipelineFrom(m).All()))
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"iter"
+	"maps"
+)
+
+// Any reports whether at least one entry satisfies f.
+func Any[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
+	return AnySeq(maps.All(m), f)
+}
+
+// All reports whether every entry satisfies f. It is true for an empty map.
+func All[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
+	return AllSeq(maps.All(m), f)
+}
+
+// None reports whether no entry satisfies f. It is true for an empty map.
+func None[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
+	return NoneSeq(maps.All(m), f)
+}
+
+// ExactlyOne reports whether exactly one entry satisfies f.
+func ExactlyOne[K comparable, V any](m map[K]V, f func(key K, val V) bool) bool {
+	return ExactlyOneSeq(maps.All(m), f)
+}
+
+// AtLeast reports whether at least n entries satisfy f.
+func AtLeast[K comparable, V any](m map[K]V, n int, f func(key K, val V) bool) bool {
+	return AtLeastSeq(maps.All(m), n, f)
+}
+
+func AnySeq[K any, V any](s iter.Seq2[K, V], f func(key K, val V) bool) bool {
+	for k, v := range s {
+		if f(k, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func AllSeq[K any, V any](s iter.Seq2[K, V], f func(key K, val V) bool) bool {
+	for k, v := range s {
+		if !f(k, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func NoneSeq[K any, V any](s iter.Seq2[K, V], f func(key K, val V) bool) bool {
+	return !AnySeq(s, f)
+}
+
+func ExactlyOneSeq[K any, V any](s iter.Seq2[K, V], f func(key K, val V) bool) bool {
+	found := false
+
+	for k, v := range s {
+		if f(k, v) {
+			if found {
+				return false
+			}
+
+			found = true
+		}
+	}
+
+	return found
+}
+
+func AtLeastSeq[K any, V any](s iter.Seq2[K, V], n int, f func(key K, val V) bool) bool {
+	if n <= 0 {
+		return true
+	}
+
+	cnt := 0
+
+	for k, v := range s {
+		if f(k, v) {
+			cnt++
+			if cnt >= n {
+				return true
+			}
+		}
+	}
+
+	return false
+}
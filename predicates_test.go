@@ -0,0 +1,134 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestAny(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+
+	t.Run("match", func(t *testing.T) {
+		assert.True(t, map_utils.Any(m, func(k, v int) bool { return v == 2 }))
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.False(t, map_utils.Any(m, func(k, v int) bool { return v > 5 }))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.False(t, map_utils.Any(map[int]int{}, func(k, v int) bool { return true }))
+	})
+}
+
+func TestAll(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+
+	t.Run("all match", func(t *testing.T) {
+		assert.True(t, map_utils.All(m, func(k, v int) bool { return v > 0 }))
+	})
+
+	t.Run("one does not match", func(t *testing.T) {
+		assert.False(t, map_utils.All(m, func(k, v int) bool { return v < 3 }))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.True(t, map_utils.All(map[int]int{}, func(k, v int) bool { return false }))
+	})
+}
+
+func TestNone(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+
+	t.Run("no match", func(t *testing.T) {
+		assert.True(t, map_utils.None(m, func(k, v int) bool { return v > 5 }))
+	})
+
+	t.Run("match", func(t *testing.T) {
+		assert.False(t, map_utils.None(m, func(k, v int) bool { return v == 2 }))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.True(t, map_utils.None(map[int]int{}, func(k, v int) bool { return true }))
+	})
+}
+
+func TestExactlyOne(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+
+	t.Run("one match", func(t *testing.T) {
+		assert.True(t, map_utils.ExactlyOne(m, func(k, v int) bool { return v == 2 }))
+	})
+
+	t.Run("two matches", func(t *testing.T) {
+		assert.False(t, map_utils.ExactlyOne(m, func(k, v int) bool { return v > 1 }))
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.False(t, map_utils.ExactlyOne(m, func(k, v int) bool { return v > 5 }))
+	})
+}
+
+func TestAtLeast(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+
+	t.Run("enough matches", func(t *testing.T) {
+		assert.True(t, map_utils.AtLeast(m, 2, func(k, v int) bool { return v > 1 }))
+	})
+
+	t.Run("not enough matches", func(t *testing.T) {
+		assert.False(t, map_utils.AtLeast(m, 3, func(k, v int) bool { return v > 1 }))
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		assert.True(t, map_utils.AtLeast(map[int]int{}, 0, func(k, v int) bool { return false }))
+	})
+}
+
+func TestPredicateSeqEarlyTermination(t *testing.T) {
+	m := map[int]int{1: 1, 2: 2, 3: 3, 4: 4}
+
+	t.Run("any", func(t *testing.T) {
+		count := 0
+		map_utils.AnySeq(maps.All(m), func(k, v int) bool {
+			count++
+			return true
+		})
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		count := 0
+		map_utils.AllSeq(maps.All(m), func(k, v int) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("exactly one", func(t *testing.T) {
+		count := 0
+		map_utils.ExactlyOneSeq(maps.All(m), func(k, v int) bool {
+			count++
+			return true
+		})
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("at least", func(t *testing.T) {
+		count := 0
+		map_utils.AtLeastSeq(maps.All(m), 2, func(k, v int) bool {
+			count++
+			return true
+		})
+		assert.Equal(t, 2, count)
+	})
+}
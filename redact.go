@@ -0,0 +1,230 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const DefaultMask = "[REDACTED]"
+
+// RedactRule selects keys to redact. Matching is case-insensitive.
+type RedactRule struct {
+	match func(key string) bool
+	mask  string
+	hash  bool
+}
+
+// MatchKey matches keys equal to one of names.
+func MatchKey(names ...string) RedactRule {
+	return RedactRule{match: func(key string) bool {
+		return slices.ContainsFunc(names, func(name string) bool {
+			return strings.EqualFold(name, key)
+		})
+	}}
+}
+
+// MatchGlob matches keys with a path.Match pattern like "*token*".
+// It panics if the pattern is malformed.
+func MatchGlob(pattern string) RedactRule {
+	pattern = strings.ToLower(pattern)
+	if _, err := path.Match(pattern, ""); err != nil {
+		panic(fmt.Errorf("map_utils.MatchGlob: %w", err))
+	}
+
+	return RedactRule{match: func(key string) bool {
+		ok, _ := path.Match(pattern, strings.ToLower(key))
+		return ok
+	}}
+}
+
+// MatchRegexp matches keys containing a match of expr.
+// It panics if the expression cannot be compiled.
+func MatchRegexp(expr string) RedactRule {
+	re := regexp.MustCompile("(?i)" + expr)
+
+	return RedactRule{match: re.MatchString}
+}
+
+// WithMask replaces matched values with mask instead of DefaultMask.
+func (r RedactRule) WithMask(mask string) RedactRule {
+	r.mask = mask
+	return r
+}
+
+// WithHash replaces matched values with "sha256:" and the hex digest of the
+// value, so equal secrets can still be correlated. Strings are hashed as is,
+// other values in their canonical JSON encoding.
+func (r RedactRule) WithHash() RedactRule {
+	r.hash = true
+	return r
+}
+
+// Redact returns a copy of m with the values of all keys matching one of the
+// rules replaced, together with the sorted paths of the redacted values.
+// Nested maps and slices are walked, including typed ones like map[string]string,
+// m itself is not modified. Structs and pointers are not walked.
+func Redact(m map[string]any, rules ...RedactRule) (map[string]any, []string) {
+	var paths []string
+
+	result := redactMap("", m, rules, false, &paths)
+	slices.Sort(paths)
+
+	return result, paths
+}
+
+// RedactInPlace works like Redact but modifies m and its nested maps and slices.
+func RedactInPlace(m map[string]any, rules ...RedactRule) []string {
+	var paths []string
+
+	redactMap("", m, rules, true, &paths)
+	slices.Sort(paths)
+
+	return paths
+}
+
+func redactMap(prefix string, m map[string]any, rules []RedactRule, inPlace bool, paths *[]string) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	result := m
+	if !inPlace {
+		result = make(map[string]any, len(m))
+	}
+
+	for k, v := range m {
+		p := joinPath(prefix, k)
+
+		if idx := slices.IndexFunc(rules, func(r RedactRule) bool { return r.match(k) }); idx >= 0 {
+			result[k] = rules[idx].replace(v)
+			*paths = append(*paths, p)
+			continue
+		}
+
+		result[k] = redactValue(p, v, rules, inPlace, paths)
+	}
+
+	return result
+}
+
+func redactValue(p string, val any, rules []RedactRule, inPlace bool, paths *[]string) any {
+	switch v := val.(type) {
+	case map[string]any:
+		return redactMap(p, v, rules, inPlace, paths)
+	case []any:
+		result := v
+		if !inPlace {
+			result = make([]any, len(v))
+		}
+
+		for i, item := range v {
+			result[i] = redactValue(fmt.Sprintf("%s[%d]", p, i), item, rules, inPlace, paths)
+		}
+
+		return result
+	default:
+		return redactReflect(p, val, rules, inPlace, paths)
+	}
+}
+
+// redactReflect walks typed maps with string keys like map[string]string and
+// typed slices. A redacted value is replaced by the mask if the map can hold a
+// string, otherwise by the zero value of its element type.
+func redactReflect(p string, val any, rules []RedactRule, inPlace bool, paths *[]string) any {
+	rv := reflect.ValueOf(val)
+
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String && !rv.IsNil():
+		result := rv
+		if !inPlace {
+			result = reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		}
+
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := iter.Key()
+			elem := iter.Value()
+			kp := joinPath(p, k.String())
+
+			if idx := slices.IndexFunc(rules, func(r RedactRule) bool { return r.match(k.String()) }); idx >= 0 {
+				result.SetMapIndex(k, redactedElem(rules[idx].replace(elem.Interface()), rv.Type().Elem()))
+				*paths = append(*paths, kp)
+				continue
+			}
+
+			result.SetMapIndex(k, redactElem(kp, elem, rules, inPlace, paths))
+		}
+
+		return result.Interface()
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 && !rv.IsNil():
+		result := rv
+		if !inPlace {
+			result = reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		}
+
+		for i := range rv.Len() {
+			result.Index(i).Set(redactElem(fmt.Sprintf("%s[%d]", p, i), rv.Index(i), rules, inPlace, paths))
+		}
+
+		return result.Interface()
+	default:
+		return val
+	}
+}
+
+func redactElem(p string, elem reflect.Value, rules []RedactRule, inPlace bool, paths *[]string) reflect.Value {
+	if elem.Kind() == reflect.Interface && elem.IsNil() {
+		return elem
+	}
+
+	result := reflect.ValueOf(redactValue(p, elem.Interface(), rules, inPlace, paths))
+	if elem.Kind() == reflect.Interface {
+		return result.Convert(elem.Type())
+	}
+
+	return result
+}
+
+func redactedElem(mask string, typ reflect.Type) reflect.Value {
+	v := reflect.ValueOf(mask)
+
+	switch {
+	case v.Type().AssignableTo(typ):
+		return v
+	case typ.Kind() == reflect.String:
+		return v.Convert(typ)
+	default:
+		return reflect.Zero(typ)
+	}
+}
+
+func (r RedactRule) replace(val any) string {
+	if r.hash {
+		text := []byte(formatText(val))
+		if _, ok := val.(string); !ok {
+			var buf bytes.Buffer
+			if err := writeCanonical(&buf, "", val); err == nil {
+				text = buf.Bytes()
+			}
+		}
+
+		return fmt.Sprintf("sha256:%x", sha256.Sum256(text))
+	}
+
+	if r.mask != "" {
+		return r.mask
+	}
+
+	return DefaultMask
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestRedact(t *testing.T) {
+	newMap := func() map[string]any {
+		return map[string]any{
+			"user":     "bob",
+			"Password": "secret",
+			"db": map[string]any{
+				"host":       "localhost",
+				"api_token":  "abc",
+				"credential": map[string]any{"user": "x"},
+			},
+			"hooks": []any{
+				map[string]any{"url": "https://example.com", "SecretKey": "k"},
+			},
+		}
+	}
+
+	t.Run("redact by name, glob and regexp", func(t *testing.T) {
+		m := newMap()
+
+		result, paths := map_utils.Redact(m,
+			map_utils.MatchKey("password"),
+			map_utils.MatchGlob("*TOKEN"),
+			map_utils.MatchRegexp(`^secret|^credential$`),
+		)
+
+		assert.Equal(t, map[string]any{
+			"user":     "bob",
+			"Password": "[REDACTED]",
+			"db": map[string]any{
+				"host":       "localhost",
+				"api_token":  "[REDACTED]",
+				"credential": "[REDACTED]",
+			},
+			"hooks": []any{
+				map[string]any{"url": "https://example.com", "SecretKey": "[REDACTED]"},
+			},
+		}, result)
+
+		assert.Equal(t, []string{"Password", "db.api_token", "db.credential", "hooks[0].SecretKey"}, paths)
+		assert.Equal(t, newMap(), m)
+	})
+
+	t.Run("custom mask and hash", func(t *testing.T) {
+		result, _ := map_utils.Redact(newMap(),
+			map_utils.MatchKey("password").WithHash(),
+			map_utils.MatchGlob("api_*").WithMask("***"),
+		)
+
+		assert.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("secret"))), result["Password"])
+		assert.Equal(t, "***", result["db"].(map[string]any)["api_token"])
+	})
+
+	t.Run("hash of nested value is stable", func(t *testing.T) {
+		rule := map_utils.MatchKey("credential").WithHash()
+
+		a, _ := map_utils.Redact(map[string]any{"credential": map[string]any{"a": 1, "b": 2}}, rule)
+		b, _ := map_utils.Redact(map[string]any{"credential": map[string]any{"b": 2, "a": 1}}, rule)
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("in place", func(t *testing.T) {
+		m := newMap()
+
+		paths := map_utils.RedactInPlace(m, map_utils.MatchKey("password", "api_token"))
+		assert.Equal(t, []string{"Password", "db.api_token"}, paths)
+		assert.Equal(t, "[REDACTED]", m["Password"])
+		assert.Equal(t, "[REDACTED]", m["db"].(map[string]any)["api_token"])
+	})
+
+	t.Run("typed maps and slices", func(t *testing.T) {
+		headers := map[string]string{"Authorization": "Bearer x", "Accept": "*/*"}
+		m := map[string]any{
+			"headers": headers,
+			"hosts":   []map[string]string{{"name": "a", "token": "t"}},
+			"pins":    map[string]int{"pin": 1234, "count": 2},
+		}
+
+		result, paths := map_utils.Redact(m, map_utils.MatchKey("authorization", "token", "pin"))
+		assert.Equal(t, []string{"headers.Authorization", "hosts[0].token", "pins.pin"}, paths)
+		assert.Equal(t, map[string]any{
+			"headers": map[string]string{"Authorization": "[REDACTED]", "Accept": "*/*"},
+			"hosts":   []map[string]string{{"name": "a", "token": "[REDACTED]"}},
+			"pins":    map[string]int{"pin": 0, "count": 2},
+		}, result)
+		assert.Equal(t, "Bearer x", headers["Authorization"])
+
+		paths = map_utils.RedactInPlace(m, map_utils.MatchKey("authorization"))
+		assert.Equal(t, []string{"headers.Authorization"}, paths)
+		assert.Equal(t, "[REDACTED]", headers["Authorization"])
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		result, paths := map_utils.Redact(newMap(), map_utils.MatchKey("nothing"))
+		assert.Equal(t, newMap(), result)
+		assert.Empty(t, paths)
+	})
+
+	t.Run("invalid patterns", func(t *testing.T) {
+		assert.Panics(t, func() { map_utils.MatchGlob("[") })
+		assert.Panics(t, func() { map_utils.MatchRegexp("(") })
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type RenderStyle int
+
+const (
+	// RenderYAML renders a YAML-like indented view.
+	RenderYAML RenderStyle = iota
+	// RenderTree renders a tree with box drawing branches.
+	RenderTree
+)
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiKey     = "\x1b[36m"
+	ansiString  = "\x1b[32m"
+	ansiNumber  = "\x1b[33m"
+	ansiLiteral = "\x1b[35m"
+	ansiMuted   = "\x1b[90m"
+)
+
+type RenderOptions struct {
+	Style RenderStyle
+	// Indent is the number of spaces per level in RenderYAML, 2 if zero.
+	Indent int
+	// MaxDepth is the number of nesting levels shown. Deeper maps and slices
+	// are collapsed. Zero means no limit.
+	MaxDepth int
+	// MaxValueLength truncates longer values to this number of runes. Zero means no limit.
+	MaxValueLength int
+	// Mask replaces the value at path if it returns true. Nested maps and
+	// slices are masked as a whole.
+	Mask func(path string, val any) (string, bool)
+	// Color enables ANSI colors.
+	Color bool
+}
+
+type renderNode struct {
+	label    string
+	value    string
+	list     bool
+	children []renderNode
+}
+
+// Render returns a multi-line view of m with sorted keys. Nested maps and
+// slices of any type are rendered as subtrees.
+func Render[K cmp.Ordered, V any](m map[K]V, opts RenderOptions) string {
+	if opts.Indent <= 0 {
+		opts.Indent = 2
+	}
+
+	root := opts.node("", "", reflect.ValueOf(m), 0)
+	if root.children == nil {
+		return root.value
+	}
+
+	var lines []string
+	if opts.Style == RenderTree {
+		lines = opts.tree(root.children, "")
+	} else {
+		lines = opts.yaml(root.children)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (o RenderOptions) node(path string, label string, rv reflect.Value, depth int) renderNode {
+	n := renderNode{label: label}
+
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			n.value = o.paint(ansiLiteral, "null")
+			return n
+		}
+
+		rv = rv.Elem()
+	}
+
+	if o.Mask != nil && rv.IsValid() && path != "" {
+		if masked, ok := o.Mask(path, rv.Interface()); ok {
+			n.value = o.paint(ansiMuted, masked)
+			return n
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		n.value = o.paint(ansiLiteral, "null")
+	case reflect.Map:
+		if rv.Len() == 0 {
+			n.value = o.paint(ansiMuted, "{}")
+			return n
+		}
+
+		if o.MaxDepth > 0 && depth >= o.MaxDepth {
+			n.value = o.paint(ansiMuted, fmt.Sprintf("{...%d keys}", rv.Len()))
+			return n
+		}
+
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, compareValues)
+
+		for _, k := range keys {
+			key := formatText(k.Interface())
+			n.children = append(n.children, o.node(joinPath(path, key), key, rv.MapIndex(k), depth+1))
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			n.value = o.scalar(rv)
+			return n
+		}
+
+		if rv.Len() == 0 {
+			n.value = o.paint(ansiMuted, "[]")
+			return n
+		}
+
+		if o.MaxDepth > 0 && depth >= o.MaxDepth {
+			n.value = o.paint(ansiMuted, fmt.Sprintf("[...%d items]", rv.Len()))
+			return n
+		}
+
+		for i := range rv.Len() {
+			child := o.node(fmt.Sprintf("%s[%d]", path, i), fmt.Sprintf("[%d]", i), rv.Index(i), depth+1)
+			child.list = true
+			n.children = append(n.children, child)
+		}
+	default:
+		n.value = o.scalar(rv)
+	}
+
+	return n
+}
+
+func (o RenderOptions) scalar(rv reflect.Value) string {
+	val := rv.Interface()
+
+	switch rv.Kind() {
+	case reflect.String:
+		s := truncate(rv.String(), o.MaxValueLength)
+		if s == "" || strings.TrimSpace(s) != s {
+			s = strconv.Quote(s)
+		} else {
+			s = quote(s)
+		}
+
+		return o.paint(ansiString, s)
+	case reflect.Bool:
+		return o.paint(ansiLiteral, formatText(val))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return o.paint(ansiNumber, truncate(formatText(val), o.MaxValueLength))
+	default:
+		return truncate(formatText(val), o.MaxValueLength)
+	}
+}
+
+func (o RenderOptions) yaml(nodes []renderNode) []string {
+	var lines []string
+
+	indent := strings.Repeat(" ", o.Indent)
+
+	for _, n := range nodes {
+		label := o.paint(ansiKey, n.label) + ":"
+		if n.list {
+			label = "-"
+		}
+
+		if n.children == nil {
+			lines = append(lines, label+" "+n.value)
+			continue
+		}
+
+		children := o.yaml(n.children)
+
+		if n.list {
+			// compact YAML form: the first child follows the dash on the same line
+			pad := strings.Repeat(" ", max(o.Indent, 2)-1)
+			lines = append(lines, "-"+pad+children[0])
+
+			for _, line := range children[1:] {
+				lines = append(lines, " "+pad+line)
+			}
+
+			continue
+		}
+
+		lines = append(lines, label)
+		for _, line := range children {
+			lines = append(lines, indent+line)
+		}
+	}
+
+	return lines
+}
+
+func (o RenderOptions) tree(nodes []renderNode, prefix string) []string {
+	var lines []string
+
+	for i, n := range nodes {
+		branch, next := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		line := prefix + branch + o.paint(ansiKey, n.label)
+		if n.children == nil {
+			line += ": " + n.value
+		}
+
+		lines = append(lines, line)
+		lines = append(lines, o.tree(n.children, prefix+next)...)
+	}
+
+	return lines
+}
+
+func (o RenderOptions) paint(color string, s string) string {
+	if !o.Color {
+		return s
+	}
+
+	return color + s + ansiReset
+}
+
+// compareValues orders numbers by value and everything else by its text.
+func compareValues(a, b reflect.Value) int {
+	if fa, ok := toFloat(a.Interface()); ok {
+		if fb, ok := toFloat(b.Interface()); ok {
+			return cmp.Compare(fa, fb)
+		}
+	}
+
+	return strings.Compare(formatText(a.Interface()), formatText(b.Interface()))
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestRender(t *testing.T) {
+	m := map[string]any{
+		"name": "demo",
+		"db": map[string]any{
+			"host":     "localhost",
+			"password": "secret",
+			"ports":    []any{80, 443},
+		},
+		"users": []any{
+			map[string]any{"id": 1, "name": "bob"},
+		},
+		"empty":   map[string]any{},
+		"enabled": true,
+		"note":    "",
+		"missing": nil,
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		expected := strings.Join([]string{
+			"db:",
+			"  host: localhost",
+			"  password: secret",
+			"  ports:",
+			"    - 80",
+			"    - 443",
+			"empty: {}",
+			"enabled: true",
+			"missing: null",
+			"name: demo",
+			`note: ""`,
+			"users:",
+			"  - id: 1",
+			"    name: bob",
+		}, "\n")
+
+		assert.Equal(t, expected, map_utils.Render(m, map_utils.RenderOptions{}))
+	})
+
+	t.Run("tree", func(t *testing.T) {
+		expected := strings.Join([]string{
+			"├── db",
+			"│   ├── host: localhost",
+			"│   ├── password: secret",
+			"│   └── ports",
+			"│       ├── [0]: 80",
+			"│       └── [1]: 443",
+			"├── empty: {}",
+			"├── enabled: true",
+			"├── missing: null",
+			"├── name: demo",
+			`├── note: ""`,
+			"└── users",
+			"    └── [0]",
+			"        ├── id: 1",
+			"        └── name: bob",
+		}, "\n")
+
+		assert.Equal(t, expected, map_utils.Render(m, map_utils.RenderOptions{Style: map_utils.RenderTree}))
+	})
+
+	t.Run("depth limit", func(t *testing.T) {
+		result := map_utils.Render(map[string]any{"a": map[string]any{"b": map[string]any{"c": 1}, "d": []int{1, 2}}}, map_utils.RenderOptions{MaxDepth: 2})
+		assert.Equal(t, "a:\n  b: {...1 keys}\n  d: [...2 items]", result)
+	})
+
+	t.Run("truncate and mask", func(t *testing.T) {
+		result := map_utils.Render(m, map_utils.RenderOptions{
+			MaxValueLength: 3,
+			MaxDepth:       2,
+			Mask: func(path string, val any) (string, bool) {
+				return "***", strings.HasSuffix(path, "password")
+			},
+		})
+		assert.Contains(t, result, "  host: loc...")
+		assert.Contains(t, result, "  password: ***")
+		assert.Contains(t, result, "name: dem...")
+		assert.Contains(t, result, "  ports: [...2 items]")
+	})
+
+	t.Run("color", func(t *testing.T) {
+		result := map_utils.Render(map[string]int{"a": 1}, map_utils.RenderOptions{Color: true})
+		assert.Equal(t, "\x1b[36ma\x1b[0m: \x1b[33m1\x1b[0m", result)
+	})
+
+	t.Run("numeric keys and indent", func(t *testing.T) {
+		result := map_utils.Render(map[int]map[int]string{10: {1: "x"}, 2: {}}, map_utils.RenderOptions{Indent: 4})
+		assert.Equal(t, "2: {}\n10:\n    1: x", result)
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.Equal(t, "{}", map_utils.Render(map[string]int{}, map_utils.RenderOptions{}))
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type Kind int
+
+const (
+	KindAny Kind = iota
+	KindString
+	KindNumber
+	KindInt
+	KindBool
+	KindMap
+	KindSlice
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindNumber:
+		return "number"
+	case KindInt:
+		return "integer"
+	case KindBool:
+		return "bool"
+	case KindMap:
+		return "map"
+	case KindSlice:
+		return "slice"
+	default:
+		return "any"
+	}
+}
+
+// Schema describes the expected entries of a map[string]any by key.
+type Schema map[string]*Field
+
+// Field describes a single value. Min and Max bound the value of numbers
+// and the length of strings, slices and maps.
+type Field struct {
+	Kind     Kind
+	Required bool
+	Min      *float64
+	Max      *float64
+	Pattern  *regexp.Regexp
+	Fields   Schema
+	Items    *Field
+	Validate func(val any) error
+}
+
+type Violation struct {
+	Path    string
+	Message string
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Error()
+	}
+
+	return fmt.Sprintf("map_utils.Validate: %s", strings.Join(msgs, "; "))
+}
+
+// Bound returns a pointer to v for use as Field.Min or Field.Max.
+func Bound(v float64) *float64 {
+	return &v
+}
+
+// Validate checks m against the schema and returns a *ValidationError
+// holding every violation, or nil if m is valid.
+func (s Schema) Validate(m map[string]any) error {
+	violations := s.validate("", m)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: violations}
+}
+
+func (s Schema) validate(path string, m map[string]any) []Violation {
+	var violations []Violation
+
+	required := slices.Collect(maps.Keys(Select(s, func(key string, f *Field) bool {
+		return f != nil && f.Required
+	})))
+
+	for _, k := range MissingKeys(m, required...) {
+		violations = append(violations, Violation{Path: joinPath(path, k), Message: "required key is missing"})
+	}
+
+	keys := slices.Collect(maps.Keys(s))
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		val, ok := m[k]
+		if !ok {
+			continue
+		}
+
+		violations = append(violations, s[k].validate(joinPath(path, k), val)...)
+	}
+
+	slices.SortStableFunc(violations, func(a, b Violation) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return violations
+}
+
+func (f *Field) validate(path string, val any) []Violation {
+	if f == nil {
+		return nil
+	}
+
+	if !f.Kind.matches(val) {
+		return []Violation{{Path: path, Message: fmt.Sprintf("expected %v, got %T", f.Kind, val)}}
+	}
+
+	var violations []Violation
+
+	if size, ok := measure(val); ok {
+		if f.Min != nil && size < *f.Min {
+			violations = append(violations, Violation{Path: path, Message: fmt.Sprintf("must be at least %v", *f.Min)})
+		}
+
+		if f.Max != nil && size > *f.Max {
+			violations = append(violations, Violation{Path: path, Message: fmt.Sprintf("must be at most %v", *f.Max)})
+		}
+	}
+
+	if f.Pattern != nil {
+		if str, ok := val.(string); ok && !f.Pattern.MatchString(str) {
+			violations = append(violations, Violation{Path: path, Message: fmt.Sprintf("does not match pattern %q", f.Pattern.String())})
+		}
+	}
+
+	switch v := val.(type) {
+	case map[string]any:
+		if f.Fields != nil {
+			violations = append(violations, f.Fields.validate(path, v)...)
+		}
+	case []any:
+		if f.Items != nil {
+			for i, item := range v {
+				violations = append(violations, f.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+			}
+		}
+	}
+
+	if f.Validate != nil {
+		if err := f.Validate(val); err != nil {
+			violations = append(violations, Violation{Path: path, Message: err.Error()})
+		}
+	}
+
+	return violations
+}
+
+func (k Kind) matches(val any) bool {
+	switch k {
+	case KindString:
+		_, ok := val.(string)
+		return ok
+	case KindNumber:
+		_, ok := toFloat(val)
+		return ok
+	case KindInt:
+		f, ok := toFloat(val)
+		return ok && f == float64(int64(f))
+	case KindBool:
+		_, ok := val.(bool)
+		return ok
+	case KindMap:
+		_, ok := val.(map[string]any)
+		return ok
+	case KindSlice:
+		_, ok := val.([]any)
+		return ok
+	default:
+		return true
+	}
+}
+
+func measure(val any) (float64, bool) {
+	switch v := val.(type) {
+	case string:
+		return float64(len([]rune(v))), true
+	case []any:
+		return float64(len(v)), true
+	case map[string]any:
+		return float64(len(v)), true
+	default:
+		return toFloat(val)
+	}
+}
+
+func toFloat(val any) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
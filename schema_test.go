@@ -0,0 +1,88 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	schema := map_utils.Schema{
+		"name": {Kind: map_utils.KindString, Required: true, Min: map_utils.Bound(2)},
+		"age":  {Kind: map_utils.KindInt, Min: map_utils.Bound(0), Max: map_utils.Bound(150)},
+		"mail": {Kind: map_utils.KindString, Pattern: regexp.MustCompile(`^[^@]+@[^@]+$`)},
+		"address": {
+			Kind: map_utils.KindMap,
+			Fields: map_utils.Schema{
+				"city": {Kind: map_utils.KindString, Required: true},
+			},
+		},
+		"tags": {
+			Kind:  map_utils.KindSlice,
+			Max:   map_utils.Bound(3),
+			Items: &map_utils.Field{Kind: map_utils.KindString},
+		},
+		"role": {
+			Validate: func(val any) error {
+				if val != "admin" && val != "user" {
+					return errors.New("unknown role")
+				}
+				return nil
+			},
+		},
+	}
+
+	t.Run("valid payload", func(t *testing.T) {
+		var m map[string]any
+		err := json.Unmarshal([]byte(`{"name":"Bob","age":42,"mail":"bob@example.com","address":{"city":"Berlin"},"tags":["a","b"],"role":"user"}`), &m)
+		assert.NoError(t, err)
+		assert.NoError(t, schema.Validate(m))
+	})
+
+	t.Run("collect all violations", func(t *testing.T) {
+		m := map[string]any{
+			"age":     1.5,
+			"mail":    "invalid",
+			"address": map[string]any{},
+			"tags":    []any{"a", 1, "c", "d"},
+			"role":    "guest",
+		}
+
+		err := schema.Validate(m)
+
+		var verr *map_utils.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []map_utils.Violation{
+			{Path: "address.city", Message: "required key is missing"},
+			{Path: "age", Message: "expected integer, got float64"},
+			{Path: "mail", Message: `does not match pattern "^[^@]+@[^@]+$"`},
+			{Path: "name", Message: "required key is missing"},
+			{Path: "role", Message: "unknown role"},
+			{Path: "tags", Message: "must be at most 3"},
+			{Path: "tags[1]", Message: "expected string, got int"},
+		}, verr.Violations)
+	})
+
+	t.Run("range violations", func(t *testing.T) {
+		err := schema.Validate(map[string]any{"name": "B", "age": -1})
+		assert.EqualError(t, err, "map_utils.Validate: age: must be at least 0; name: must be at least 2")
+	})
+
+	t.Run("unknown keys are ignored", func(t *testing.T) {
+		assert.NoError(t, schema.Validate(map[string]any{"name": "Bob", "other": true}))
+	})
+
+	t.Run("nil fields accept any value", func(t *testing.T) {
+		assert.NoError(t, map_utils.Schema{"a": nil}.Validate(map[string]any{}))
+		assert.NoError(t, map_utils.Schema{"a": nil}.Validate(map[string]any{"a": 1}))
+	})
+}
@@ -11,44 +11,136 @@ import (
 )
 
 func RemapFuncSeq[K1 comparable, V1 any, K2 comparable, V2 any](m iter.Seq2[K1, V1], f func(key K1, val V1) (K2, V2, error)) iter.Seq2[K2, V2] {
-	return func(yield func(K2, V2) bool) {
+	return mustSeq2(RemapFuncErrSeq(ToErrSeq2(m), f))
+}
+
+func WeightFuncSeq[K comparable, V any, S cmp.Ordered](m iter.Seq2[K, V], f func(key K, val V) S) iter.Seq[S] {
+	return func(yield func(S) bool) {
 		for k, v := range m {
-			k2, v2, err := f(k, v)
-			if err != nil {
-				panic(err)
+			s := f(k, v)
+
+			if !yield(s) {
+				return
 			}
+		}
+	}
+}
 
-			if !yield(k2, v2) {
+func SliceFuncSeq[K comparable, V any, R any](m iter.Seq2[K, V], f func(key K, val V) (*R, error)) iter.Seq[R] {
+	return mustSeq(SliceFuncErrSeq(ToErrSeq2(m), f))
+}
+
+func FlattenSeq[K comparable, V any](m iter.Seq2[K, V]) iter.Seq[any] {
+	return func(yield func(any) bool) {
+		for k, v := range m {
+			for _, val := range []any{k, v} {
+				if !yield(val) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func FilterSeq2[K any, V any](m iter.Seq2[K, V], f func(key K, val V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if f(k, v) && !yield(k, v) {
 				return
 			}
 		}
 	}
 }
 
-func WeightFuncSeq[K comparable, V any, S cmp.Ordered](m iter.Seq2[K, V], f func(key K, val V) S) iter.Seq[S] {
-	return func(yield func(S) bool) {
+func MapKeysSeq[K1 any, V any, K2 any](m iter.Seq2[K1, V], f func(key K1, val V) K2) iter.Seq2[K2, V] {
+	return func(yield func(K2, V) bool) {
 		for k, v := range m {
-			s := f(k, v)
+			if !yield(f(k, v), v) {
+				return
+			}
+		}
+	}
+}
 
-			if !yield(s) {
+func MapValuesSeq[K any, V1 any, V2 any](m iter.Seq2[K, V1], f func(key K, val V1) V2) iter.Seq2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for k, v := range m {
+			if !yield(k, f(k, v)) {
 				return
 			}
 		}
 	}
 }
 
-func SliceFuncSeq[K comparable, V any, R any](m iter.Seq2[K, V], f func(key K, val V) (*R, error)) iter.Seq[R] {
-	return func(yield func(R) bool) {
-		var nilPtr *R
+func TakeSeq2[K any, V any](m iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
 
+		i := 0
 		for k, v := range m {
-			val, err := f(k, v)
-			if err != nil {
-				panic(err)
+			if !yield(k, v) {
+				return
 			}
 
-			if val != nilPtr {
-				if !yield(*val) {
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+func SkipSeq2[K any, V any](m iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := 0
+		for k, v := range m {
+			if i < n {
+				i++
+				continue
+			}
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields entries until f returns false for the first time.
+func TakeWhile[K any, V any](m iter.Seq2[K, V], f func(key K, val V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !f(k, v) || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile skips entries until f returns false for the first time and yields all remaining entries.
+func DropWhile[K any, V any](m iter.Seq2[K, V], f func(key K, val V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		dropping := true
+		for k, v := range m {
+			if dropping && f(k, v) {
+				continue
+			}
+
+			dropping = false
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func ConcatSeq2[K any, V any](seqs ...iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, m := range seqs {
+			for k, v := range m {
+				if !yield(k, v) {
 					return
 				}
 			}
@@ -56,14 +148,91 @@ func SliceFuncSeq[K comparable, V any, R any](m iter.Seq2[K, V], f func(key K, v
 	}
 }
 
-func FlattenSeq[K comparable, V any](m iter.Seq2[K, V]) iter.Seq[any] {
-	return func(yield func(any) bool) {
+// ZipSeq pairs the elements of keys and vals and stops at the end of the shorter sequence.
+func ZipSeq[K any, V any](keys iter.Seq[K], vals iter.Seq[V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		next, stop := iter.Pull(vals)
+		defer stop()
+
+		for k := range keys {
+			v, ok := next()
+			if !ok || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func EnumerateSeq[T any](s iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range s {
+			if !yield(i, v) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
+func SwapSeq2[K any, V any](m iter.Seq2[K, V]) iter.Seq2[V, K] {
+	return func(yield func(V, K) bool) {
 		for k, v := range m {
-			for _, val := range []any{k, v} {
-				if !yield(val) {
+			if !yield(v, k) {
+				return
+			}
+		}
+	}
+}
+
+func KeysSeq[K any, V any](m iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func ValuesSeq[K any, V any](m iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq2 collects the entries into maps of up to size entries.
+// It panics if size is less than 1.
+func ChunkSeq2[K comparable, V any](m iter.Seq2[K, V], size int) iter.Seq[map[K]V] {
+	if size < 1 {
+		panic("map_utils.ChunkSeq2: size must be at least 1")
+	}
+
+	return func(yield func(map[K]V) bool) {
+		chunk := make(map[K]V, size)
+		cnt := 0
+
+		for k, v := range m {
+			chunk[k] = v
+			cnt++
+
+			if cnt == size {
+				if !yield(chunk) {
 					return
 				}
+
+				chunk = make(map[K]V, size)
+				cnt = 0
 			}
 		}
+
+		if cnt > 0 {
+			yield(chunk)
+		}
 	}
 }
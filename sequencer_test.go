@@ -8,6 +8,7 @@ package map_utils_test
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"maps"
 	"slices"
 	"sort"
@@ -250,3 +251,166 @@ func TestFlattenSeq(t *testing.T) {
 		assert.Equal(t, 3, count)
 	})
 }
+
+func sortedSeq(m map[string]int) iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
+
+func collectPairs[K any, V any](seq iter.Seq2[K, V]) [][2]any {
+	pairs := [][2]any{}
+	for k, v := range seq {
+		pairs = append(pairs, [2]any{k, v})
+	}
+	return pairs
+}
+
+func TestSeq2Combinators(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+	t.Run("filter", func(t *testing.T) {
+		result := map_utils.FilterSeq2(sortedSeq(m), func(k string, v int) bool { return v%2 == 0 })
+		assert.Equal(t, [][2]any{{"b", 2}, {"d", 4}}, collectPairs(result))
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		result := map_utils.MapKeysSeq(sortedSeq(m), func(k string, v int) string { return k + k })
+		assert.Equal(t, map[string]int{"aa": 1, "bb": 2, "cc": 3, "dd": 4}, maps.Collect(result))
+	})
+
+	t.Run("map values", func(t *testing.T) {
+		result := map_utils.MapValuesSeq(sortedSeq(m), func(k string, v int) string { return fmt.Sprint(v * 2) })
+		assert.Equal(t, map[string]string{"a": "2", "b": "4", "c": "6", "d": "8"}, maps.Collect(result))
+	})
+
+	t.Run("take and skip", func(t *testing.T) {
+		assert.Equal(t, [][2]any{{"a", 1}, {"b", 2}}, collectPairs(map_utils.TakeSeq2(sortedSeq(m), 2)))
+		assert.Empty(t, collectPairs(map_utils.TakeSeq2(sortedSeq(m), 0)))
+		assert.Equal(t, [][2]any{{"c", 3}, {"d", 4}}, collectPairs(map_utils.SkipSeq2(sortedSeq(m), 2)))
+		assert.Empty(t, collectPairs(map_utils.SkipSeq2(sortedSeq(m), 10)))
+	})
+
+	t.Run("take while and drop while", func(t *testing.T) {
+		less := func(k string, v int) bool { return v < 3 }
+		assert.Equal(t, [][2]any{{"a", 1}, {"b", 2}}, collectPairs(map_utils.TakeWhile(sortedSeq(m), less)))
+		assert.Equal(t, [][2]any{{"c", 3}, {"d", 4}}, collectPairs(map_utils.DropWhile(sortedSeq(m), less)))
+	})
+
+	t.Run("drop while only drops leading entries", func(t *testing.T) {
+		odd := func(k string, v int) bool { return v%2 == 1 }
+		assert.Equal(t, [][2]any{{"b", 2}, {"c", 3}, {"d", 4}}, collectPairs(map_utils.DropWhile(sortedSeq(m), odd)))
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		result := map_utils.ConcatSeq2(sortedSeq(map[string]int{"a": 1}), sortedSeq(map[string]int{}), sortedSeq(map[string]int{"b": 2}))
+		assert.Equal(t, [][2]any{{"a", 1}, {"b", 2}}, collectPairs(result))
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		result := map_utils.ZipSeq(slices.Values([]string{"a", "b", "c"}), slices.Values([]int{1, 2}))
+		assert.Equal(t, [][2]any{{"a", 1}, {"b", 2}}, collectPairs(result))
+	})
+
+	t.Run("enumerate", func(t *testing.T) {
+		result := map_utils.EnumerateSeq(slices.Values([]string{"x", "y"}))
+		assert.Equal(t, [][2]any{{0, "x"}, {1, "y"}}, collectPairs(result))
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		assert.Equal(t, map[int]string{1: "a", 2: "b", 3: "c", 4: "d"}, maps.Collect(map_utils.SwapSeq2(sortedSeq(m))))
+	})
+
+	t.Run("keys and values", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c", "d"}, slices.Collect(map_utils.KeysSeq(sortedSeq(m))))
+		assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(map_utils.ValuesSeq(sortedSeq(m))))
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		result := slices.Collect(map_utils.ChunkSeq2(sortedSeq(map[string]int{"a": 1, "b": 2, "c": 3}), 2))
+		assert.Equal(t, []map[string]int{{"a": 1, "b": 2}, {"c": 3}}, result)
+		assert.Panics(t, func() { map_utils.ChunkSeq2(sortedSeq(m), 0) })
+	})
+}
+
+func TestSeq2CombinatorsEarlyTermination(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+	tests := map[string]func(iter.Seq2[string, int]) iter.Seq2[string, int]{
+		"filter": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.FilterSeq2(s, func(string, int) bool { return true })
+		},
+		"map keys": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.MapKeysSeq(s, func(k string, v int) string { return k })
+		},
+		"map values": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.MapValuesSeq(s, func(k string, v int) int { return v })
+		},
+		"take": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.TakeSeq2(s, 3)
+		},
+		"skip": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.SkipSeq2(s, 1)
+		},
+		"take while": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.TakeWhile(s, func(string, int) bool { return true })
+		},
+		"drop while": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.DropWhile(s, func(string, int) bool { return false })
+		},
+		"concat": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.ConcatSeq2(s, s)
+		},
+		"swap twice": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.SwapSeq2(map_utils.SwapSeq2(s))
+		},
+		"zip": func(s iter.Seq2[string, int]) iter.Seq2[string, int] {
+			return map_utils.ZipSeq(map_utils.KeysSeq(s), map_utils.ValuesSeq(s))
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			count := 0
+			fn(sortedSeq(m))(func(k string, v int) bool {
+				count++
+				return false
+			})
+
+			assert.Equal(t, 1, count)
+		})
+	}
+
+	t.Run("enumerate", func(t *testing.T) {
+		count := 0
+		map_utils.EnumerateSeq(slices.Values([]int{1, 2, 3}))(func(int, int) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		assert.Equal(t, []string{"a"}, slices.Collect(map_utils.KeysSeq(map_utils.TakeSeq2(sortedSeq(m), 1))))
+
+		count := 0
+		map_utils.KeysSeq(sortedSeq(m))(func(string) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		count := 0
+		map_utils.ChunkSeq2(sortedSeq(m), 1)(func(map[string]int) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+}
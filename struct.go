@@ -0,0 +1,701 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// FieldError reports a failed conversion together with the path of the failing field.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+type DecodeOption func(d *decoder)
+
+// WeaklyTyped enables lenient conversions like string to number, number to
+// string or numbers to time.Duration (nanoseconds) and time.Time (Unix seconds).
+// Without it, durations and times are only decoded from strings like "5s" and
+// RFC 3339 timestamps.
+func WeaklyTyped() DecodeOption {
+	return func(d *decoder) {
+		d.weak = true
+	}
+}
+
+// ToStruct decodes m into a new value of type T. Field names are taken from
+// the "map" struct tag, the "json" struct tag or the field name.
+func ToStruct[T any](m map[string]any, opts ...DecodeOption) (T, error) {
+	var result T
+
+	d := newDecoder(opts...)
+	if err := d.decode("", m, reflect.ValueOf(&result).Elem()); err != nil {
+		return result, fmt.Errorf("map_utils.ToStruct: %w", err)
+	}
+
+	return result, nil
+}
+
+// FromStruct encodes a struct or a pointer to a struct into a map.
+// Nested structs become map[string]any and slices become []any.
+func FromStruct(v any) (map[string]any, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("map_utils.FromStruct: nil pointer")
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("map_utils.FromStruct: expected struct, got %T", v)
+	}
+
+	result, err := encodeStruct("", rv)
+	if err != nil {
+		return nil, fmt.Errorf("map_utils.FromStruct: %w", err)
+	}
+
+	return result, nil
+}
+
+type structField struct {
+	name      string
+	index     []int
+	tagged    bool
+	omitEmpty bool
+}
+
+// structFields returns the fields of t with the fields of embedded structs
+// promoted. Like in encoding/json, a field hides deeper fields with the same
+// name and fields of the same depth hide each other unless exactly one is tagged.
+func structFields(t reflect.Type) []structField {
+	fields := collectFields(t)
+
+	byName := map[string][]structField{}
+	for _, f := range fields {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var result []structField
+	for _, f := range fields {
+		if dominant, ok := dominantField(byName[f.name]); ok && slices.Equal(dominant.index, f.index) {
+			result = append(result, f)
+		}
+	}
+
+	return result
+}
+
+func dominantField(fields []structField) (structField, bool) {
+	depth := len(fields[0].index)
+	for _, f := range fields {
+		depth = min(depth, len(f.index))
+	}
+
+	var shallow, tagged []structField
+	for _, f := range fields {
+		if len(f.index) == depth {
+			shallow = append(shallow, f)
+			if f.tagged {
+				tagged = append(tagged, f)
+			}
+		}
+	}
+
+	switch {
+	case len(shallow) == 1:
+		return shallow[0], true
+	case len(tagged) == 1:
+		return tagged[0], true
+	default:
+		return structField{}, false
+	}
+}
+
+func collectFields(t reflect.Type) []structField {
+	var fields []structField
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("map")
+		if tag == "" {
+			tag = f.Tag.Get("json")
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct && ft != timeType {
+			for _, sf := range collectFields(ft) {
+				sf.index = append([]int{i}, sf.index...)
+				fields = append(fields, sf)
+			}
+
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		tagged := name != ""
+		if !tagged {
+			name = f.Name
+		}
+
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			tagged:    tagged,
+			omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+
+	return fields
+}
+
+type decoder struct {
+	weak bool
+}
+
+func newDecoder(opts ...DecodeOption) *decoder {
+	d := &decoder{}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (d *decoder) decode(path string, val any, out reflect.Value) error {
+	if val == nil {
+		out.SetZero()
+		return nil
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Type().AssignableTo(out.Type()) {
+		out.Set(rv)
+		return nil
+	}
+
+	switch out.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(out.Type().Elem())
+		if err := d.decode(path, val, elem.Elem()); err != nil {
+			return err
+		}
+
+		out.Set(elem)
+		return nil
+	case reflect.Struct:
+		if out.Type() == timeType {
+			return d.decodeTime(path, val, out)
+		}
+
+		return d.decodeStruct(path, rv, out)
+	case reflect.Map:
+		return d.decodeMap(path, rv, out)
+	case reflect.Slice, reflect.Array:
+		return d.decodeSlice(path, rv, out)
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if out.Type() == durationType {
+			return d.decodeDuration(path, rv, out)
+		}
+
+		return d.decodeScalar(path, rv, out)
+	}
+
+	return d.errorf(path, val, out.Type())
+}
+
+func (d *decoder) decodeStruct(path string, rv reflect.Value, out reflect.Value) error {
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return d.errorf(path, rv.Interface(), out.Type())
+	}
+
+	entries := map[string]reflect.Value{}
+	for _, k := range rv.MapKeys() {
+		entries[k.String()] = rv.MapIndex(k)
+	}
+
+	keys := slices.Sorted(maps.Keys(entries))
+
+	for _, f := range structFields(out.Type()) {
+		// prefer the exact key, then the first case-insensitive match in sorted order
+		entry, ok := entries[f.name]
+		if !ok {
+			if idx := slices.IndexFunc(keys, func(k string) bool { return strings.EqualFold(k, f.name) }); idx >= 0 {
+				entry, ok = entries[keys[idx]], true
+			}
+		}
+
+		if !ok {
+			continue
+		}
+
+		field, err := fieldByIndex(out, f.index)
+		if err != nil {
+			return &FieldError{Path: joinPath(path, f.name), Err: err}
+		}
+
+		if err := d.decode(joinPath(path, f.name), entry.Interface(), field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeMap(path string, rv reflect.Value, out reflect.Value) error {
+	if rv.Kind() != reflect.Map {
+		return d.errorf(path, rv.Interface(), out.Type())
+	}
+
+	result := reflect.MakeMapWithSize(out.Type(), rv.Len())
+
+	for _, k := range rv.MapKeys() {
+		itemPath := joinPath(path, fmt.Sprint(k.Interface()))
+
+		key := reflect.New(out.Type().Key()).Elem()
+		if err := d.decodeKey(itemPath, k.Interface(), key); err != nil {
+			return err
+		}
+
+		val := reflect.New(out.Type().Elem()).Elem()
+		if err := d.decode(itemPath, rv.MapIndex(k).Interface(), val); err != nil {
+			return err
+		}
+
+		result.SetMapIndex(key, val)
+	}
+
+	out.Set(result)
+	return nil
+}
+
+func (d *decoder) decodeKey(path string, val any, out reflect.Value) error {
+	if s, ok := val.(string); ok && !d.weak {
+		// map keys are always strings in decoded payloads, so parse them leniently
+		weak := &decoder{weak: true}
+		return weak.decode(path, s, out)
+	}
+
+	return d.decode(path, val, out)
+}
+
+func (d *decoder) decodeSlice(path string, rv reflect.Value, out reflect.Value) error {
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return d.errorf(path, rv.Interface(), out.Type())
+	}
+
+	var result reflect.Value
+	if out.Kind() == reflect.Array {
+		if rv.Len() > out.Len() {
+			return &FieldError{Path: path, Err: fmt.Errorf("too many elements for %v", out.Type())}
+		}
+
+		result = reflect.New(out.Type()).Elem()
+	} else {
+		result = reflect.MakeSlice(out.Type(), rv.Len(), rv.Len())
+	}
+
+	for i := range rv.Len() {
+		if err := d.decode(fmt.Sprintf("%s[%d]", path, i), rv.Index(i).Interface(), result.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	out.Set(result)
+	return nil
+}
+
+// decodeTime accepts RFC 3339 strings and, if weakly typed, Unix timestamps in seconds.
+func (d *decoder) decodeTime(path string, val any, out reflect.Value) error {
+	if v, ok := val.(string); ok {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return &FieldError{Path: path, Err: err}
+		}
+
+		out.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if f, ok := toFloat(val); ok && d.weak {
+		sec, frac := math.Modf(f)
+		out.Set(reflect.ValueOf(time.Unix(int64(sec), int64(frac*1e9)).UTC()))
+		return nil
+	}
+
+	return d.errorf(path, val, out.Type())
+}
+
+// decodeDuration accepts strings like "5s" and, if weakly typed, numbers as nanoseconds.
+func (d *decoder) decodeDuration(path string, rv reflect.Value, out reflect.Value) error {
+	if rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		return d.decodeScalar(path, rv, out)
+	}
+
+	if rv.Kind() == reflect.String {
+		return d.parseString(path, rv.String(), out)
+	}
+
+	if d.weak {
+		return d.decodeScalar(path, rv, out)
+	}
+
+	return d.errorf(path, rv.Interface(), out.Type())
+}
+
+func (d *decoder) decodeScalar(path string, rv reflect.Value, out reflect.Value) error {
+	if rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			out.SetZero()
+			return nil
+		}
+
+		return d.decode(path, rv.Elem().Interface(), out)
+	}
+
+	if d.weak && rv.Kind() == reflect.String {
+		return d.parseString(path, rv.String(), out)
+	}
+
+	switch out.Kind() {
+	case reflect.String:
+		switch {
+		case rv.Kind() == reflect.String:
+			out.SetString(rv.String())
+			return nil
+		case d.weak && rv.Kind() == reflect.Bool:
+			out.SetString(strconv.FormatBool(rv.Bool()))
+			return nil
+		case d.weak && rv.CanInt():
+			out.SetString(strconv.FormatInt(rv.Int(), 10))
+			return nil
+		case d.weak && rv.CanUint():
+			out.SetString(strconv.FormatUint(rv.Uint(), 10))
+			return nil
+		case d.weak && rv.CanFloat():
+			out.SetString(strconv.FormatFloat(rv.Float(), 'f', -1, 64))
+			return nil
+		}
+	case reflect.Bool:
+		if rv.Kind() == reflect.Bool {
+			out.SetBool(rv.Bool())
+			return nil
+		}
+
+		if f, ok := toFloat(rv.Interface()); ok && d.weak {
+			out.SetBool(f != 0)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case rv.CanInt():
+			return setInt(path, rv.Int(), out)
+		case rv.CanUint():
+			if rv.Uint() > math.MaxInt64 {
+				return &FieldError{Path: path, Err: fmt.Errorf("value %v overflows %v", rv.Uint(), out.Type())}
+			}
+
+			return setInt(path, int64(rv.Uint()), out)
+		case rv.CanFloat():
+			f := rv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return &FieldError{Path: path, Err: fmt.Errorf("value %v is not a valid %v", f, out.Type())}
+			}
+
+			return setInt(path, int64(f), out)
+		case d.weak && rv.Kind() == reflect.Bool:
+			return setInt(path, boolToInt(rv.Bool()), out)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		switch {
+		case rv.CanUint():
+			return setUint(path, rv.Uint(), out)
+		case rv.CanInt():
+			if rv.Int() < 0 {
+				return &FieldError{Path: path, Err: fmt.Errorf("value %v overflows %v", rv.Int(), out.Type())}
+			}
+
+			return setUint(path, uint64(rv.Int()), out)
+		case rv.CanFloat():
+			f := rv.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				return &FieldError{Path: path, Err: fmt.Errorf("value %v is not a valid %v", f, out.Type())}
+			}
+
+			return setUint(path, uint64(f), out)
+		case d.weak && rv.Kind() == reflect.Bool:
+			return setUint(path, uint64(boolToInt(rv.Bool())), out)
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := toFloat(rv.Interface()); ok {
+			out.SetFloat(f)
+			return nil
+		}
+
+		if d.weak && rv.Kind() == reflect.Bool {
+			out.SetFloat(float64(boolToInt(rv.Bool())))
+			return nil
+		}
+	}
+
+	if rv.Type().ConvertibleTo(out.Type()) && rv.Kind() == out.Kind() {
+		out.Set(rv.Convert(out.Type()))
+		return nil
+	}
+
+	return d.errorf(path, rv.Interface(), out.Type())
+}
+
+func (d *decoder) parseString(path string, s string, out reflect.Value) error {
+	var err error
+
+	switch out.Kind() {
+	case reflect.String:
+		out.SetString(s)
+		return nil
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(s); err == nil {
+			out.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if out.Type() == durationType {
+			var dur time.Duration
+			if dur, err = time.ParseDuration(s); err == nil {
+				out.SetInt(int64(dur))
+				return nil
+			}
+
+			break
+		}
+
+		var i int64
+		if i, err = strconv.ParseInt(s, 0, out.Type().Bits()); err == nil {
+			out.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		if u, err = strconv.ParseUint(s, 0, out.Type().Bits()); err == nil {
+			out.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(s, out.Type().Bits()); err == nil {
+			out.SetFloat(f)
+			return nil
+		}
+	}
+
+	if err != nil {
+		return &FieldError{Path: path, Err: fmt.Errorf("cannot parse %q as %v: %w", s, out.Type(), err)}
+	}
+
+	return d.errorf(path, s, out.Type())
+}
+
+func (d *decoder) errorf(path string, val any, t reflect.Type) error {
+	return &FieldError{Path: path, Err: fmt.Errorf("cannot convert %T to %v", val, t)}
+}
+
+func setInt(path string, i int64, out reflect.Value) error {
+	if out.OverflowInt(i) {
+		return &FieldError{Path: path, Err: fmt.Errorf("value %v overflows %v", i, out.Type())}
+	}
+
+	out.SetInt(i)
+	return nil
+}
+
+func setUint(path string, u uint64, out reflect.Value) error {
+	if out.OverflowUint(u) {
+		return &FieldError{Path: path, Err: fmt.Errorf("value %v overflows %v", u, out.Type())}
+	}
+
+	out.SetUint(u)
+	return nil
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %v", v.Type().Elem())
+				}
+
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, nil
+}
+
+func encodeStruct(path string, rv reflect.Value) (map[string]any, error) {
+	result := map[string]any{}
+
+	for _, f := range structFields(rv.Type()) {
+		field, ok := encodeFieldByIndex(rv, f.index)
+		if !ok {
+			continue
+		}
+
+		if f.omitEmpty && isEmptyValue(field) {
+			continue
+		}
+
+		val, err := encodeValue(joinPath(path, f.name), field)
+		if err != nil {
+			return nil, err
+		}
+
+		result[f.name] = val
+	}
+
+	return result, nil
+}
+
+func encodeFieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, true
+}
+
+func encodeValue(path string, rv reflect.Value) (any, error) {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+
+		return encodeValue(path, rv.Elem())
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			return rv.Interface(), nil
+		}
+
+		return encodeStruct(path, rv)
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+
+		result := make(map[string]any, rv.Len())
+		for _, k := range rv.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+
+			val, err := encodeValue(joinPath(path, key), rv.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = val
+		}
+
+		return result, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, nil
+		}
+
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Interface(), nil
+		}
+
+		result := make([]any, rv.Len())
+		for i := range rv.Len() {
+			val, err := encodeValue(fmt.Sprintf("%s[%d]", path, i), rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = val
+		}
+
+		return result, nil
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return nil, &FieldError{Path: path, Err: fmt.Errorf("unsupported type %v", rv.Type())}
+	default:
+		return rv.Interface(), nil
+	}
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
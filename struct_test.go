@@ -0,0 +1,254 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+type testBase struct {
+	ID int `json:"id"`
+}
+
+type testAddress struct {
+	City string `json:"city"`
+	Zip  string `map:"zip_code" json:"zip"`
+}
+
+type testPerson struct {
+	testBase
+	Name     string         `json:"name"`
+	Age      uint8          `json:"age,omitempty"`
+	Score    float64        `json:"score"`
+	Active   bool           `json:"active"`
+	Address  *testAddress   `json:"address,omitempty"`
+	Tags     []string       `json:"tags,omitempty"`
+	Labels   map[string]int `json:"labels,omitempty"`
+	Timeout  time.Duration  `json:"timeout,omitempty"`
+	Internal string         `json:"-"`
+	Extra    map[string]any `json:"extra,omitempty"`
+	Matrix   [][]int        `json:"matrix,omitempty"`
+	Aliases  map[int]string `json:"aliases,omitempty"`
+	private  string
+}
+
+type testInner struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+type testOuterFirst struct {
+	Name string `json:"name"`
+	testInner
+}
+
+type testOuterLast struct {
+	testInner
+	Name string `json:"name"`
+}
+
+func TestToStruct(t *testing.T) {
+	t.Run("decode json payload", func(t *testing.T) {
+		var m map[string]any
+		err := json.Unmarshal([]byte(`{
+			"id": 7,
+			"name": "Bob",
+			"age": 42,
+			"score": 1.5,
+			"active": true,
+			"address": {"city": "Berlin", "zip_code": "10115"},
+			"tags": ["a", "b"],
+			"labels": {"x": 1},
+			"extra": {"nested": {"deep": true}},
+			"matrix": [[1, 2], [3]],
+			"aliases": {"1": "one"}
+		}`), &m)
+		assert.NoError(t, err)
+
+		p, err := map_utils.ToStruct[testPerson](m)
+		assert.NoError(t, err)
+		assert.Equal(t, testPerson{
+			testBase: testBase{ID: 7},
+			Name:     "Bob",
+			Age:      42,
+			Score:    1.5,
+			Active:   true,
+			Address:  &testAddress{City: "Berlin", Zip: "10115"},
+			Tags:     []string{"a", "b"},
+			Labels:   map[string]int{"x": 1},
+			Extra:    map[string]any{"nested": map[string]any{"deep": true}},
+			Matrix:   [][]int{{1, 2}, {3}},
+			Aliases:  map[int]string{1: "one"},
+		}, p)
+	})
+
+	t.Run("decode into pointer", func(t *testing.T) {
+		p, err := map_utils.ToStruct[*testAddress](map[string]any{"City": "Hamburg"})
+		assert.NoError(t, err)
+		assert.Equal(t, &testAddress{City: "Hamburg"}, p)
+	})
+
+	t.Run("error path for nested field", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{
+			"address": map[string]any{"city": 5},
+		})
+
+		var ferr *map_utils.FieldError
+		assert.ErrorAs(t, err, &ferr)
+		assert.Equal(t, "address.city", ferr.Path)
+		assert.EqualError(t, err, "map_utils.ToStruct: address.city: cannot convert int to string")
+	})
+
+	t.Run("error path for slice element", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"tags": []any{"a", true}})
+		assert.EqualError(t, err, "map_utils.ToStruct: tags[1]: cannot convert bool to string")
+	})
+
+	t.Run("fractional number to int", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"id": 1.5})
+		assert.EqualError(t, err, "map_utils.ToStruct: id: value 1.5 is not a valid int")
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"age": 300})
+		assert.EqualError(t, err, "map_utils.ToStruct: age: value 300 overflows uint8")
+	})
+
+	t.Run("strict mode rejects strings", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"age": "42"})
+		assert.Error(t, err)
+	})
+
+	t.Run("weakly typed conversions", func(t *testing.T) {
+		p, err := map_utils.ToStruct[testPerson](map[string]any{
+			"id":      "12",
+			"name":    42,
+			"age":     "30",
+			"score":   "2.5",
+			"active":  "true",
+			"timeout": "5s",
+		}, map_utils.WeaklyTyped())
+
+		assert.NoError(t, err)
+		assert.Equal(t, testPerson{
+			testBase: testBase{ID: 12},
+			Name:     "42",
+			Age:      30,
+			Score:    2.5,
+			Active:   true,
+			Timeout:  5 * time.Second,
+		}, p)
+	})
+
+	t.Run("weakly typed parse error", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"age": "old"}, map_utils.WeaklyTyped())
+		assert.ErrorContains(t, err, `age: cannot parse "old" as uint8`)
+	})
+
+	t.Run("shallow fields hide embedded fields", func(t *testing.T) {
+		input := map[string]any{"name": "outer", "kind": "k"}
+
+		first, err := map_utils.ToStruct[testOuterFirst](input)
+		assert.NoError(t, err)
+		assert.Equal(t, testOuterFirst{Name: "outer", testInner: testInner{Kind: "k"}}, first)
+
+		last, err := map_utils.ToStruct[testOuterLast](input)
+		assert.NoError(t, err)
+		assert.Equal(t, testOuterLast{Name: "outer", testInner: testInner{Kind: "k"}}, last)
+	})
+
+	t.Run("case-insensitive keys", func(t *testing.T) {
+		for range 10 {
+			result, err := map_utils.ToStruct[testInner](map[string]any{"NAME": "upper", "Name": "title", "nAmE": "mixed", "KIND": "k"})
+			assert.NoError(t, err)
+			assert.Equal(t, testInner{Name: "upper", Kind: "k"}, result)
+		}
+
+		result, err := map_utils.ToStruct[testInner](map[string]any{"NAME": "upper", "name": "exact"})
+		assert.NoError(t, err)
+		assert.Equal(t, "exact", result.Name)
+	})
+
+	t.Run("not a map", func(t *testing.T) {
+		_, err := map_utils.ToStruct[testPerson](map[string]any{"address": "Berlin"})
+		assert.EqualError(t, err, "map_utils.ToStruct: address: cannot convert string to map_utils_test.testAddress")
+	})
+}
+
+func TestFromStruct(t *testing.T) {
+	t.Run("encode struct", func(t *testing.T) {
+		p := testPerson{
+			testBase: testBase{ID: 7},
+			Name:     "Bob",
+			Address:  &testAddress{City: "Berlin", Zip: "10115"},
+			Tags:     []string{"a"},
+			Internal: "hidden",
+			Matrix:   [][]int{{1}},
+			private:  "hidden",
+		}
+
+		m, err := map_utils.FromStruct(&p)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"id":      7,
+			"name":    "Bob",
+			"score":   0.0,
+			"active":  false,
+			"address": map[string]any{"city": "Berlin", "zip_code": "10115"},
+			"tags":    []any{"a"},
+			"matrix":  []any{[]any{1}},
+		}, m)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		p := testPerson{
+			testBase: testBase{ID: 1},
+			Name:     "Alice",
+			Age:      30,
+			Labels:   map[string]int{"a": 1},
+			Aliases:  map[int]string{2: "two"},
+			Timeout:  time.Minute,
+		}
+
+		m, err := map_utils.FromStruct(p)
+		assert.NoError(t, err)
+
+		result, err := map_utils.ToStruct[testPerson](m)
+		assert.NoError(t, err)
+		assert.Equal(t, p, result)
+	})
+
+	t.Run("shallow fields hide embedded fields", func(t *testing.T) {
+		first, err := map_utils.FromStruct(testOuterFirst{Name: "outer", testInner: testInner{Name: "inner", Kind: "k"}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "outer", "kind": "k"}, first)
+
+		last, err := map_utils.FromStruct(testOuterLast{Name: "outer", testInner: testInner{Name: "inner", Kind: "k"}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "outer", "kind": "k"}, last)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := map_utils.FromStruct(42)
+		assert.EqualError(t, err, "map_utils.FromStruct: expected struct, got int")
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		var p *testPerson
+		_, err := map_utils.FromStruct(p)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		_, err := map_utils.FromStruct(struct{ F func() }{F: func() {}})
+		assert.EqualError(t, err, "map_utils.FromStruct: F: unsupported type func()")
+	})
+}
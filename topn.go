@@ -0,0 +1,130 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// TopN returns the n entries with the largest values according to compare,
+// largest first. Ties are ordered by key. It runs in O(len(m) log n).
+func TopN[K cmp.Ordered, V any](m map[K]V, n int, compare func(a, b V) int) []Entry[K, V] {
+	return TopNSeq(maps.All(m), n, compare)
+}
+
+// BottomN returns the n entries with the smallest values according to compare,
+// smallest first. Ties are ordered by key.
+func BottomN[K cmp.Ordered, V any](m map[K]V, n int, compare func(a, b V) int) []Entry[K, V] {
+	return BottomNSeq(maps.All(m), n, compare)
+}
+
+func TopNSeq[K cmp.Ordered, V any](s iter.Seq2[K, V], n int, compare func(a, b V) int) []Entry[K, V] {
+	return selectN(s, n, func(a, b Entry[K, V]) int {
+		return cmp.Or(compare(b.Value, a.Value), cmp.Compare(a.Key, b.Key))
+	})
+}
+
+func BottomNSeq[K cmp.Ordered, V any](s iter.Seq2[K, V], n int, compare func(a, b V) int) []Entry[K, V] {
+	return selectN(s, n, func(a, b Entry[K, V]) int {
+		return cmp.Or(compare(a.Value, b.Value), cmp.Compare(a.Key, b.Key))
+	})
+}
+
+// TopNFuncSeq returns the n entries with the largest weight calculated by f
+// like in WeightFuncSeq, largest first. Ties are ordered by key.
+func TopNFuncSeq[K cmp.Ordered, V any, S cmp.Ordered](s iter.Seq2[K, V], n int, f func(key K, val V) S) []Entry[K, V] {
+	entries := selectN(MapValuesSeq(s, weighted(f)), n, func(a, b Entry[K, weightedValue[V, S]]) int {
+		return cmp.Or(cmp.Compare(b.Value.weight, a.Value.weight), cmp.Compare(a.Key, b.Key))
+	})
+
+	return unweighted(entries)
+}
+
+// BottomNFuncSeq returns the n entries with the smallest weight calculated by f,
+// smallest first. Ties are ordered by key.
+func BottomNFuncSeq[K cmp.Ordered, V any, S cmp.Ordered](s iter.Seq2[K, V], n int, f func(key K, val V) S) []Entry[K, V] {
+	entries := selectN(MapValuesSeq(s, weighted(f)), n, func(a, b Entry[K, weightedValue[V, S]]) int {
+		return cmp.Or(cmp.Compare(a.Value.weight, b.Value.weight), cmp.Compare(a.Key, b.Key))
+	})
+
+	return unweighted(entries)
+}
+
+type weightedValue[V any, S cmp.Ordered] struct {
+	val    V
+	weight S
+}
+
+func weighted[K any, V any, S cmp.Ordered](f func(key K, val V) S) func(key K, val V) weightedValue[V, S] {
+	return func(key K, val V) weightedValue[V, S] {
+		return weightedValue[V, S]{val: val, weight: f(key, val)}
+	}
+}
+
+func unweighted[K any, V any, S cmp.Ordered](entries []Entry[K, weightedValue[V, S]]) []Entry[K, V] {
+	result := make([]Entry[K, V], len(entries))
+	for i, e := range entries {
+		result[i] = Entry[K, V]{Key: e.Key, Value: e.Value.val}
+	}
+
+	return result
+}
+
+// selectN keeps the n first entries according to order in a heap whose
+// root is the last of the kept entries.
+func selectN[K any, V any](s iter.Seq2[K, V], n int, order func(a, b Entry[K, V]) int) []Entry[K, V] {
+	if n <= 0 {
+		return []Entry[K, V]{}
+	}
+
+	h := &entryHeap[K, V]{entries: []Entry[K, V]{}, order: order}
+
+	for k, v := range s {
+		e := Entry[K, V]{Key: k, Value: v}
+
+		if h.Len() < n {
+			heap.Push(h, e)
+		} else if order(e, h.entries[0]) < 0 {
+			h.entries[0] = e
+			heap.Fix(h, 0)
+		}
+	}
+
+	slices.SortFunc(h.entries, order)
+	return h.entries
+}
+
+type entryHeap[K any, V any] struct {
+	entries []Entry[K, V]
+	order   func(a, b Entry[K, V]) int
+}
+
+func (h *entryHeap[K, V]) Len() int {
+	return len(h.entries)
+}
+
+func (h *entryHeap[K, V]) Less(i, j int) bool {
+	return h.order(h.entries[i], h.entries[j]) > 0
+}
+
+func (h *entryHeap[K, V]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+}
+
+func (h *entryHeap[K, V]) Push(x any) {
+	h.entries = append(h.entries, x.(Entry[K, V]))
+}
+
+func (h *entryHeap[K, V]) Pop() any {
+	last := h.entries[len(h.entries)-1]
+	h.entries = h.entries[:len(h.entries)-1]
+
+	return last
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestTopN(t *testing.T) {
+	m := map[string]int{"a": 5, "b": 9, "c": 1, "d": 9, "e": 3, "f": 7}
+
+	t.Run("top 3", func(t *testing.T) {
+		assert.Equal(t, []map_utils.Entry[string, int]{
+			{Key: "b", Value: 9},
+			{Key: "d", Value: 9},
+			{Key: "f", Value: 7},
+		}, map_utils.TopN(m, 3, cmp.Compare[int]))
+	})
+
+	t.Run("bottom 2", func(t *testing.T) {
+		assert.Equal(t, []map_utils.Entry[string, int]{
+			{Key: "c", Value: 1},
+			{Key: "e", Value: 3},
+		}, map_utils.BottomN(m, 2, cmp.Compare[int]))
+	})
+
+	t.Run("ties are broken by key", func(t *testing.T) {
+		ties := map[string]int{"z": 1, "y": 1, "x": 1, "w": 1}
+		assert.Equal(t, []map_utils.Entry[string, int]{{Key: "w", Value: 1}, {Key: "x", Value: 1}}, map_utils.TopN(ties, 2, cmp.Compare[int]))
+		assert.Equal(t, []map_utils.Entry[string, int]{{Key: "w", Value: 1}, {Key: "x", Value: 1}}, map_utils.BottomN(ties, 2, cmp.Compare[int]))
+	})
+
+	t.Run("n larger than map", func(t *testing.T) {
+		result := map_utils.TopN(m, 100, cmp.Compare[int])
+		assert.Len(t, result, len(m))
+		assert.Equal(t, "c", result[len(result)-1].Key)
+	})
+
+	t.Run("zero or negative n", func(t *testing.T) {
+		assert.Empty(t, map_utils.TopN(m, 0, cmp.Compare[int]))
+		assert.Empty(t, map_utils.BottomN(m, -1, cmp.Compare[int]))
+	})
+
+	t.Run("empty results are not nil", func(t *testing.T) {
+		assert.Equal(t, []map_utils.Entry[string, int]{}, map_utils.TopN(map[string]int{}, 3, cmp.Compare[int]))
+		assert.Equal(t, []map_utils.Entry[string, int]{}, map_utils.TopN(m, 0, cmp.Compare[int]))
+	})
+
+	t.Run("deterministic for large input", func(t *testing.T) {
+		large := map[string]int{}
+		for i := range 1000 {
+			large[fmt.Sprintf("k%04d", i)] = i % 10
+		}
+
+		first := map_utils.TopN(large, 5, cmp.Compare[int])
+		for range 5 {
+			assert.Equal(t, first, map_utils.TopN(large, 5, cmp.Compare[int]))
+		}
+
+		assert.Equal(t, []map_utils.Entry[string, int]{
+			{Key: "k0009", Value: 9},
+			{Key: "k0019", Value: 9},
+			{Key: "k0029", Value: 9},
+			{Key: "k0039", Value: 9},
+			{Key: "k0049", Value: 9},
+		}, first)
+	})
+}
+
+func TestTopNSeq(t *testing.T) {
+	m := map[string]int{"a": 5, "b": 9, "c": 1}
+
+	t.Run("seq", func(t *testing.T) {
+		assert.Equal(t, []map_utils.Entry[string, int]{{Key: "b", Value: 9}}, map_utils.TopNSeq(maps.All(m), 1, cmp.Compare[int]))
+		assert.Equal(t, []map_utils.Entry[string, int]{{Key: "c", Value: 1}}, map_utils.BottomNSeq(maps.All(m), 1, cmp.Compare[int]))
+	})
+
+	t.Run("weight func", func(t *testing.T) {
+		users := map[string]map[string]int{
+			"bob":   {"posts": 3, "likes": 10},
+			"alice": {"posts": 5, "likes": 2},
+			"carol": {"posts": 1, "likes": 1},
+		}
+
+		score := func(k string, v map[string]int) int {
+			return v["posts"] + v["likes"]
+		}
+
+		top := map_utils.TopNFuncSeq(maps.All(users), 2, score)
+		assert.Equal(t, []string{"bob", "alice"}, []string{top[0].Key, top[1].Key})
+		assert.Equal(t, users["bob"], top[0].Value)
+
+		bottom := map_utils.BottomNFuncSeq(maps.All(users), 1, score)
+		assert.Equal(t, "carol", bottom[0].Key)
+	})
+}
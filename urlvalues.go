@@ -0,0 +1,272 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type NestingStyle int
+
+const (
+	// NestBrackets writes nested keys as a[b][c].
+	NestBrackets NestingStyle = iota
+	// NestDots writes nested keys as a.b.c.
+	NestDots
+)
+
+type SliceStyle int
+
+const (
+	// SliceRepeat writes slices as repeated keys a=1&a=2.
+	SliceRepeat SliceStyle = iota
+	// SliceIndexed writes slices with an index a[0]=1&a[1]=2 or a.0=1&a.1=2.
+	SliceIndexed
+	// SliceBrackets writes slices as a[]=1&a[]=2.
+	SliceBrackets
+)
+
+type URLOptions struct {
+	Nesting NestingStyle
+	Slices  SliceStyle
+	// Encode converts non-string values to text. Values are formatted
+	// with their error message, TextMarshaler output or fmt.Sprint if nil.
+	Encode func(val any) (string, error)
+}
+
+// ToURLValues encodes m as url.Values. Nested maps and slices are written
+// according to opts. Slices containing maps or slices are always indexed.
+func ToURLValues(m map[string]any, opts URLOptions) (url.Values, error) {
+	result := url.Values{}
+
+	if err := encodeURLMap(result, "", m, opts); err != nil {
+		return nil, fmt.Errorf("map_utils.ToURLValues: %w", err)
+	}
+
+	return result, nil
+}
+
+// FromURLValues decodes url.Values into a map. Keys in bracket style are
+// expanded into nested maps, dotted keys only if opts.Nesting is NestDots.
+// Repeated keys, a[] keys and consecutive indexes starting at 0 become []any.
+// Values are kept as strings.
+func FromURLValues(v url.Values, opts URLOptions) (map[string]any, error) {
+	result := map[string]any{}
+
+	keys := slices.Collect(maps.Keys(v))
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		path, err := parseURLKey(key, opts.Nesting)
+		if err != nil {
+			return nil, fmt.Errorf("map_utils.FromURLValues: %w", err)
+		}
+
+		if err := setURLPath(result, key, path, v[key]); err != nil {
+			return nil, fmt.Errorf("map_utils.FromURLValues: %w", err)
+		}
+	}
+
+	// only nested maps become slices, the root stays a map
+	for k, v := range result {
+		result[k] = indexMapsToSlices(v)
+	}
+
+	return result, nil
+}
+
+func encodeURLMap(result url.Values, prefix string, m map[string]any, opts URLOptions) error {
+	keys := slices.Collect(maps.Keys(m))
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if err := encodeURLValue(result, nestURLKey(prefix, k, opts.Nesting), m[k], opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeURLValue(result url.Values, key string, val any, opts URLOptions) error {
+	if nested, ok := val.(map[string]any); ok {
+		return encodeURLMap(result, key, nested, opts)
+	}
+
+	rv := reflect.ValueOf(val)
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+		indexed := opts.Slices == SliceIndexed
+		for i := range rv.Len() {
+			switch reflect.ValueOf(rv.Index(i).Interface()).Kind() {
+			case reflect.Map, reflect.Slice, reflect.Array:
+				indexed = true
+			}
+		}
+
+		for i := range rv.Len() {
+			itemKey := key
+			switch {
+			case indexed:
+				itemKey = nestURLKey(key, strconv.Itoa(i), opts.Nesting)
+			case opts.Slices == SliceBrackets:
+				itemKey = key + "[]"
+			}
+
+			if err := encodeURLValue(result, itemKey, rv.Index(i).Interface(), opts); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	text, err := encodeURLText(val, opts)
+	if err != nil {
+		return &FieldError{Path: key, Err: err}
+	}
+
+	result.Add(key, text)
+	return nil
+}
+
+func encodeURLText(val any, opts URLOptions) (string, error) {
+	if s, ok := val.(string); ok {
+		return s, nil
+	}
+
+	if opts.Encode != nil {
+		return opts.Encode(val)
+	}
+
+	switch reflect.ValueOf(val).Kind() {
+	case reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return "", fmt.Errorf("unsupported type %T", val)
+	}
+
+	return formatText(val), nil
+}
+
+func nestURLKey(prefix string, key string, style NestingStyle) string {
+	switch {
+	case prefix == "":
+		return key
+	case style == NestDots:
+		return prefix + "." + key
+	default:
+		return prefix + "[" + key + "]"
+	}
+}
+
+func parseURLKey(key string, nesting NestingStyle) ([]string, error) {
+	name, rest, ok := strings.Cut(key, "[")
+
+	path := []string{name}
+	if nesting == NestDots {
+		path = strings.Split(name, ".")
+	}
+
+	if !ok {
+		return path, nil
+	}
+
+	rest = "[" + rest
+
+	for rest != "" {
+		if rest[0] != '[' {
+			return nil, fmt.Errorf("invalid key %q", key)
+		}
+
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return nil, fmt.Errorf("invalid key %q", key)
+		}
+
+		path = append(path, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	return path, nil
+}
+
+func setURLPath(node map[string]any, key string, path []string, vals []string) error {
+	list := false
+	if len(path) > 1 && path[len(path)-1] == "" {
+		path = path[:len(path)-1]
+		list = true
+	}
+
+	for i, part := range path[:len(path)-1] {
+		if part == "" {
+			return fmt.Errorf("invalid key %q", key)
+		}
+
+		switch child := node[part].(type) {
+		case nil:
+			next := map[string]any{}
+			node[part] = next
+			node = next
+		case map[string]any:
+			node = child
+		default:
+			return fmt.Errorf("key %q conflicts with %q", key, strings.Join(path[:i+1], "."))
+		}
+	}
+
+	last := path[len(path)-1]
+	if last == "" {
+		return fmt.Errorf("invalid key %q", key)
+	}
+
+	if _, ok := node[last]; ok {
+		return fmt.Errorf("key %q conflicts with nested keys", key)
+	}
+
+	if len(vals) == 1 && !list {
+		node[last] = vals[0]
+		return nil
+	}
+
+	items := make([]any, len(vals))
+	for i, v := range vals {
+		items[i] = v
+	}
+
+	node[last] = items
+	return nil
+}
+
+func indexMapsToSlices(val any) any {
+	m, ok := val.(map[string]any)
+	if !ok {
+		return val
+	}
+
+	for k, v := range m {
+		m[k] = indexMapsToSlices(v)
+	}
+
+	if len(m) == 0 {
+		return m
+	}
+
+	items := make([]any, len(m))
+	for k, v := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+
+		items[i] = v
+	}
+
+	return items
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestToURLValues(t *testing.T) {
+	m := map[string]any{
+		"q":    "go maps",
+		"page": 2,
+		"filter": map[string]any{
+			"lang": "en",
+			"tags": []any{"a", "b"},
+		},
+	}
+
+	t.Run("bracket style", func(t *testing.T) {
+		v, err := map_utils.ToURLValues(m, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "filter%5Blang%5D=en&filter%5Btags%5D=a&filter%5Btags%5D=b&page=2&q=go+maps", v.Encode())
+	})
+
+	t.Run("dotted indexed style", func(t *testing.T) {
+		v, err := map_utils.ToURLValues(m, map_utils.URLOptions{Nesting: map_utils.NestDots, Slices: map_utils.SliceIndexed})
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{
+			"filter.lang":   {"en"},
+			"filter.tags.0": {"a"},
+			"filter.tags.1": {"b"},
+			"page":          {"2"},
+			"q":             {"go maps"},
+		}, v)
+	})
+
+	t.Run("bracket slices", func(t *testing.T) {
+		v, err := map_utils.ToURLValues(map[string]any{"ids": []int{1, 2}}, map_utils.URLOptions{Slices: map_utils.SliceBrackets})
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"ids[]": {"1", "2"}}, v)
+	})
+
+	t.Run("slices of maps are indexed", func(t *testing.T) {
+		v, err := map_utils.ToURLValues(map[string]any{"items": []any{map[string]any{"id": 1}}}, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"items[0][id]": {"1"}}, v)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		at := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
+		v, err := map_utils.ToURLValues(map[string]any{"at": at, "ok": true, "s": "text"}, map_utils.URLOptions{
+			Encode: func(val any) (string, error) {
+				switch v := val.(type) {
+				case time.Time:
+					return v.Format(time.DateOnly), nil
+				case bool:
+					if v {
+						return "1", nil
+					}
+					return "0", nil
+				}
+				return fmt.Sprint(val), nil
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"at": {"2026-01-18"}, "ok": {"1"}, "s": {"text"}}, v)
+	})
+
+	t.Run("unsupported value", func(t *testing.T) {
+		_, err := map_utils.ToURLValues(map[string]any{"a": map[string]any{"f": func() {}}}, map_utils.URLOptions{})
+		assert.EqualError(t, err, "map_utils.ToURLValues: a[f]: unsupported type func()")
+	})
+}
+
+func TestFromURLValues(t *testing.T) {
+	t.Run("bracket style", func(t *testing.T) {
+		v, err := url.ParseQuery("filter[lang]=en&filter[tags][]=a&filter[tags][]=b&items[0][id]=1&items[1][id]=2&q=go+maps&page=2")
+		assert.NoError(t, err)
+
+		m, err := map_utils.FromURLValues(v, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"q":    "go maps",
+			"page": "2",
+			"filter": map[string]any{
+				"lang": "en",
+				"tags": []any{"a", "b"},
+			},
+			"items": []any{
+				map[string]any{"id": "1"},
+				map[string]any{"id": "2"},
+			},
+		}, m)
+	})
+
+	t.Run("index keys at root", func(t *testing.T) {
+		v, err := url.ParseQuery("0=a&1=b")
+		assert.NoError(t, err)
+
+		m, err := map_utils.FromURLValues(v, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"0": "a", "1": "b"}, m)
+	})
+
+	t.Run("dotted style and repeated keys", func(t *testing.T) {
+		m, err := map_utils.FromURLValues(url.Values{
+			"a.b":   {"1"},
+			"a.c.0": {"x"},
+			"a.c.1": {"y"},
+			"ids":   {"1", "2"},
+			"one[]": {"1"},
+		}, map_utils.URLOptions{Nesting: map_utils.NestDots})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"a":   map[string]any{"b": "1", "c": []any{"x", "y"}},
+			"ids": []any{"1", "2"},
+			"one": []any{"1"},
+		}, m)
+	})
+
+	t.Run("bracket style keeps dots in keys", func(t *testing.T) {
+		v, err := url.ParseQuery("file.name=x&meta[content.type]=text")
+		assert.NoError(t, err)
+
+		m, err := map_utils.FromURLValues(v, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"file.name": "x",
+			"meta":      map[string]any{"content.type": "text"},
+		}, m)
+	})
+
+	t.Run("sparse indexes stay maps", func(t *testing.T) {
+		m, err := map_utils.FromURLValues(url.Values{"a[1]": {"x"}}, map_utils.URLOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": map[string]any{"1": "x"}}, m)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		m := map[string]any{
+			"q": "go",
+			"filter": map[string]any{
+				"tags":  []any{"a", "b"},
+				"range": map[string]any{"from": "1", "to": "9"},
+			},
+		}
+
+		for _, opts := range []map_utils.URLOptions{
+			{},
+			{Nesting: map_utils.NestDots, Slices: map_utils.SliceIndexed},
+			{Slices: map_utils.SliceBrackets},
+		} {
+			v, err := map_utils.ToURLValues(m, opts)
+			assert.NoError(t, err)
+
+			result, err := map_utils.FromURLValues(v, opts)
+			assert.NoError(t, err)
+			assert.Equal(t, m, result)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err := map_utils.FromURLValues(url.Values{"a": {"1"}, "a[b]": {"2"}}, map_utils.URLOptions{})
+		assert.EqualError(t, err, `map_utils.FromURLValues: key "a[b]" conflicts with "a"`)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := map_utils.FromURLValues(url.Values{"a[b": {"1"}}, map_utils.URLOptions{})
+		assert.EqualError(t, err, `map_utils.FromURLValues: invalid key "a[b"`)
+	})
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// WalkAction tells Walk what to do with the visited node.
+type WalkAction int
+
+const (
+	// WalkContinue keeps the node and descends into it.
+	WalkContinue WalkAction = iota
+	// WalkSkip keeps the node but doesn't descend into it. Post is still called
+	// for the node itself. It has no effect in Post.
+	WalkSkip
+	// WalkReplace replaces the node with the returned value and descends into the replacement.
+	WalkReplace
+	// WalkDelete removes the node from its parent map or slice.
+	WalkDelete
+)
+
+// WalkNode is a map entry or slice item visited by Walk.
+type WalkNode struct {
+	// Path of the node like "a.b[1].c"
+	Path string
+	// Key is the string key of a map entry or the int index of a slice item.
+	Key any
+	// Value of the node
+	Value any
+	// Parent is the map[string]any or []any containing the node.
+	Parent any
+}
+
+// WalkFunc visits a node and returns the replacement value for WalkReplace and the action to take.
+type WalkFunc func(node WalkNode) (any, WalkAction, error)
+
+// Visitor holds the callbacks of Walk. Pre is called before and Post after the
+// children of a node are visited. Both are optional.
+type Visitor struct {
+	Pre  WalkFunc
+	Post WalkFunc
+}
+
+// Walk visits all nested map[string]any and []any values of m in depth-first order
+// with map keys in sorted order. Replacements and deletions modify m in place.
+// Walk stops at the first error returned by a callback.
+func Walk(m map[string]any, visitor Visitor) error {
+	if err := visitor.walkMap("", m); err != nil {
+		return fmt.Errorf("map_utils.Walk: %w", err)
+	}
+
+	return nil
+}
+
+// MapLeaves returns a copy of m with all values except nested maps and slices replaced by f.
+func MapLeaves(m map[string]any, f func(path string, val any) any) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	result := maps.Clone(m)
+
+	_ = Walk(result, Visitor{
+		Pre: func(node WalkNode) (any, WalkAction, error) {
+			switch v := node.Value.(type) {
+			case map[string]any:
+				return maps.Clone(v), WalkReplace, nil
+			case []any:
+				return slices.Clone(v), WalkReplace, nil
+			default:
+				return f(node.Path, v), WalkReplace, nil
+			}
+		},
+	})
+
+	return result
+}
+
+func (v Visitor) walkMap(prefix string, m map[string]any) error {
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		val, keep, err := v.visit(WalkNode{Path: joinPath(prefix, k), Key: k, Value: m[k], Parent: m})
+		if err != nil {
+			return err
+		}
+
+		if keep {
+			m[k] = val
+		} else {
+			delete(m, k)
+		}
+	}
+
+	return nil
+}
+
+// walkSlice visits the items of s and returns s, or a shorter copy if items were deleted.
+func (v Visitor) walkSlice(prefix string, s []any) ([]any, error) {
+	deleted := map[int]bool{}
+
+	for i, item := range s {
+		val, keep, err := v.visit(WalkNode{Path: fmt.Sprintf("%s[%d]", prefix, i), Key: i, Value: item, Parent: s})
+		if err != nil {
+			return nil, err
+		}
+
+		if keep {
+			s[i] = val
+		} else {
+			deleted[i] = true
+		}
+	}
+
+	if len(deleted) == 0 {
+		return s, nil
+	}
+
+	result := make([]any, 0, len(s)-len(deleted))
+	for i, item := range s {
+		if !deleted[i] {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+// visit calls the callbacks for node and descends into its children. It returns
+// the new value of the node and false if the node should be deleted.
+func (v Visitor) visit(node WalkNode) (any, bool, error) {
+	descend := true
+
+	if v.Pre != nil {
+		val, action, err := v.Pre(node)
+		if err != nil {
+			return nil, false, &FieldError{Path: node.Path, Err: err}
+		}
+
+		switch action {
+		case WalkDelete:
+			return nil, false, nil
+		case WalkSkip:
+			descend = false
+		case WalkReplace:
+			node.Value = val
+		}
+	}
+
+	if descend {
+		switch val := node.Value.(type) {
+		case map[string]any:
+			if err := v.walkMap(node.Path, val); err != nil {
+				return nil, false, err
+			}
+		case []any:
+			s, err := v.walkSlice(node.Path, val)
+			if err != nil {
+				return nil, false, err
+			}
+
+			node.Value = s
+		}
+	}
+
+	if v.Post != nil {
+		val, action, err := v.Post(node)
+		if err != nil {
+			return nil, false, &FieldError{Path: node.Path, Err: err}
+		}
+
+		switch action {
+		case WalkDelete:
+			return nil, false, nil
+		case WalkReplace:
+			node.Value = val
+		}
+	}
+
+	return node.Value, true, nil
+}
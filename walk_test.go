@@ -0,0 +1,193 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func testTree() map[string]any {
+	return map[string]any{
+		"name": " app ",
+		"db": map[string]any{
+			"host":  "localhost",
+			"port":  5432,
+			"users": []any{"bob", map[string]any{"name": "alice"}},
+		},
+		"tags": []any{"a", "b", "c"},
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("order", func(t *testing.T) {
+		var pre, post []string
+
+		err := map_utils.Walk(testTree(), map_utils.Visitor{
+			Pre: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				pre = append(pre, node.Path)
+				return nil, map_utils.WalkContinue, nil
+			},
+			Post: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				post = append(post, node.Path)
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"db", "db.host", "db.port", "db.users", "db.users[0]", "db.users[1]", "db.users[1].name",
+			"name", "tags", "tags[0]", "tags[1]", "tags[2]",
+		}, pre)
+
+		assert.Equal(t, []string{
+			"db.host", "db.port", "db.users[0]", "db.users[1].name", "db.users[1]", "db.users", "db",
+			"name", "tags[0]", "tags[1]", "tags[2]", "tags",
+		}, post)
+	})
+
+	t.Run("node", func(t *testing.T) {
+		m := testTree()
+
+		var nodes []map_utils.WalkNode
+		err := map_utils.Walk(m, map_utils.Visitor{
+			Pre: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				if strings.HasPrefix(node.Path, "tags[") {
+					nodes = append(nodes, node)
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.Len(t, nodes, 3)
+		assert.Equal(t, 1, nodes[1].Key)
+		assert.Equal(t, "b", nodes[1].Value)
+		assert.Equal(t, m["tags"], nodes[1].Parent)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		var paths, post []string
+
+		err := map_utils.Walk(testTree(), map_utils.Visitor{
+			Pre: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				paths = append(paths, node.Path)
+				if node.Path == "db" {
+					return nil, map_utils.WalkSkip, nil
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+			Post: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				if !strings.HasPrefix(node.Path, "tags") {
+					post = append(post, node.Path)
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"db", "name", "tags", "tags[0]", "tags[1]", "tags[2]"}, paths)
+		assert.Equal(t, []string{"db", "name"}, post)
+	})
+
+	t.Run("replace and delete", func(t *testing.T) {
+		m := testTree()
+
+		err := map_utils.Walk(m, map_utils.Visitor{
+			Pre: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				switch val := node.Value.(type) {
+				case string:
+					if val == "b" || val == "bob" {
+						return nil, map_utils.WalkDelete, nil
+					}
+					return strings.TrimSpace(val), map_utils.WalkReplace, nil
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+			Post: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				if node.Key == "port" {
+					return nil, map_utils.WalkDelete, nil
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"name": "app",
+			"db": map[string]any{
+				"host":  "localhost",
+				"users": []any{map[string]any{"name": "alice"}},
+			},
+			"tags": []any{"a", "c"},
+		}, m)
+	})
+
+	t.Run("replace descends", func(t *testing.T) {
+		m := map[string]any{"a": 1}
+
+		var paths []string
+		err := map_utils.Walk(m, map_utils.Visitor{
+			Pre: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				paths = append(paths, node.Path)
+				if node.Path == "a" {
+					return map[string]any{"b": 2}, map_utils.WalkReplace, nil
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "a.b"}, paths)
+		assert.Equal(t, map[string]any{"a": map[string]any{"b": 2}}, m)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := map_utils.Walk(testTree(), map_utils.Visitor{
+			Post: func(node map_utils.WalkNode) (any, map_utils.WalkAction, error) {
+				if _, ok := node.Value.(int); ok {
+					return nil, map_utils.WalkContinue, errors.New("unexpected number")
+				}
+				return nil, map_utils.WalkContinue, nil
+			},
+		})
+		assert.EqualError(t, err, "map_utils.Walk: db.port: unexpected number")
+
+		var fieldErr *map_utils.FieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "db.port", fieldErr.Path)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, map_utils.Walk(nil, map_utils.Visitor{}))
+	})
+}
+
+func TestMapLeaves(t *testing.T) {
+	m := testTree()
+
+	result := map_utils.MapLeaves(m, func(path string, val any) any {
+		if s, ok := val.(string); ok {
+			return strings.ToUpper(strings.TrimSpace(s))
+		}
+		return val
+	})
+
+	assert.Equal(t, map[string]any{
+		"name": "APP",
+		"db": map[string]any{
+			"host":  "LOCALHOST",
+			"port":  5432,
+			"users": []any{"BOB", map[string]any{"name": "ALICE"}},
+		},
+		"tags": []any{"A", "B", "C"},
+	}, result)
+
+	assert.Equal(t, testTree(), m)
+	assert.Nil(t, map_utils.MapLeaves(nil, func(path string, val any) any { return val }))
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Zip builds a map from parallel key and value slices. Both slices must have the same length,
+// later keys overwrite earlier ones.
+func Zip[K comparable, V any](keys []K, vals []V) (map[K]V, error) {
+	if len(keys) != len(vals) {
+		return nil, fmt.Errorf("map_utils.Zip: length mismatch: %d keys, %d values", len(keys), len(vals))
+	}
+
+	result := make(map[K]V, len(keys))
+	for i, k := range keys {
+		result[k] = vals[i]
+	}
+
+	return result, nil
+}
+
+// ZipSeqToMap works like Zip for sequences and fails if one sequence ends before the other.
+func ZipSeqToMap[K comparable, V any](keys iter.Seq[K], vals iter.Seq[V]) (map[K]V, error) {
+	next, stop := iter.Pull(vals)
+	defer stop()
+
+	result := map[K]V{}
+	count := 0
+
+	for k := range keys {
+		v, ok := next()
+		if !ok {
+			return nil, fmt.Errorf("map_utils.Zip: length mismatch: more keys than values (%d values)", count)
+		}
+
+		result[k] = v
+		count++
+	}
+
+	if _, ok := next(); ok {
+		return nil, fmt.Errorf("map_utils.Zip: length mismatch: more values than keys (%d keys)", count)
+	}
+
+	return result, nil
+}
+
+// Unzip splits m into parallel key and value slices ordered by key.
+func Unzip[K cmp.Ordered, V any](m map[K]V) ([]K, []V) {
+	return unzipEntries(Entries(m))
+}
+
+// UnzipFunc splits m into parallel key and value slices ordered by compare.
+func UnzipFunc[K comparable, V any](m map[K]V, compare func(a, b Entry[K, V]) int) ([]K, []V) {
+	return unzipEntries(SortEntriesFunc(slices.Collect(EntriesSeq(maps.All(m))), compare))
+}
+
+func unzipEntries[K any, V any](entries []Entry[K, V]) ([]K, []V) {
+	keys := make([]K, 0, len(entries))
+	vals := make([]V, 0, len(entries))
+
+	for _, e := range entries {
+		keys = append(keys, e.Key)
+		vals = append(vals, e.Value)
+	}
+
+	return keys, vals
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Zauberhaus
+// Licensed to Zauberhaus under one or more agreements.
+// Zauberhaus licenses this file to you under the Apache 2.0 License.
+// See the LICENSE file in the project root for more information.
+
+package map_utils_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zauberhaus/map_utils"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("slices", func(t *testing.T) {
+		result, err := map_utils.Zip([]string{"a", "b", "c"}, []int{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, result)
+	})
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		result, err := map_utils.Zip([]string{"a", "a"}, []int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 2}, result)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result, err := map_utils.Zip([]string{}, []int(nil))
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		_, err := map_utils.Zip([]string{"a", "b"}, []int{1})
+		assert.EqualError(t, err, "map_utils.Zip: length mismatch: 2 keys, 1 values")
+	})
+}
+
+func TestZipSeqToMap(t *testing.T) {
+	t.Run("seq", func(t *testing.T) {
+		result, err := map_utils.ZipSeqToMap(slices.Values([]string{"a", "b"}), slices.Values([]int{1, 2}))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, result)
+	})
+
+	t.Run("more keys", func(t *testing.T) {
+		_, err := map_utils.ZipSeqToMap(slices.Values([]string{"a", "b"}), slices.Values([]int{1}))
+		assert.EqualError(t, err, "map_utils.Zip: length mismatch: more keys than values (1 values)")
+	})
+
+	t.Run("more values", func(t *testing.T) {
+		_, err := map_utils.ZipSeqToMap(slices.Values([]string{"a"}), slices.Values([]int{1, 2}))
+		assert.EqualError(t, err, "map_utils.Zip: length mismatch: more values than keys (1 keys)")
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	m := map[string]int{"b": 1, "c": 3, "a": 2}
+
+	t.Run("sorted by key", func(t *testing.T) {
+		keys, vals := map_utils.Unzip(m)
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+		assert.Equal(t, []int{2, 1, 3}, vals)
+	})
+
+	t.Run("comparator", func(t *testing.T) {
+		keys, vals := map_utils.UnzipFunc(m, func(a, b map_utils.Entry[string, int]) int {
+			return cmp.Compare(b.Value, a.Value)
+		})
+		assert.Equal(t, []string{"c", "a", "b"}, keys)
+		assert.Equal(t, []int{3, 2, 1}, vals)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		result, err := map_utils.Zip(map_utils.Unzip(m))
+		assert.NoError(t, err)
+		assert.Equal(t, m, result)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		keys, vals := map_utils.Unzip[string, int](nil)
+		assert.Empty(t, keys)
+		assert.Empty(t, vals)
+	})
+}